@@ -0,0 +1,84 @@
+package app
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/multiformats/go-multibase"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestDidOperationAuthorizationRestrictsBoundDids(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0,
+		MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: "test", Time: time.Now()}).
+		WithTxBytes([]byte("tx"))
+	app.cheqdKeeper.SetDidNamespace(ctx, "test")
+	app.cheqdKeeper.SetParams(ctx, cheqdtypes.DefaultParams())
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	stranger := sdk.AccAddress("stranger____________")
+
+	did := "did:cheqd:test:aaaaaaaaaaaaaaaa"
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	publicKeyMultibase, err := multibase.Encode(multibase.Base58BTC, pubKey)
+	require.NoError(t, err)
+
+	payload := &cheqdtypes.MsgCreateDidPayload{
+		Id: did,
+		VerificationMethod: []*cheqdtypes.VerificationMethod{{
+			Id:                 did + "#key-1",
+			Type:               cheqdtypes.Ed25519VerificationKey2020,
+			Controller:         did,
+			PublicKeyMultibase: publicKeyMultibase,
+		}},
+		Authentication:       cheqdtypes.VerificationMethodReferences(did + "#key-1"),
+		CapabilityInvocation: cheqdtypes.VerificationMethodReferences(did + "#key-1"),
+		BoundAccount:         granter.String(),
+	}
+	msg := &cheqdtypes.MsgCreateDid{
+		Payload: payload,
+		Signatures: []*cheqdtypes.SignInfo{{
+			VerificationMethodId: did + "#key-1",
+			Signature:            base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, payload.GetSignBytes())),
+		}},
+		Signer: granter.String(),
+	}
+
+	err = app.AuthzKeeper.SaveGrant(ctx, grantee, granter,
+		cheqdtypes.NewDidOperationAuthorization(sdk.MsgTypeURL(msg), did), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	t.Run("Not valid: account without grant", func(t *testing.T) {
+		_, err := app.AuthzKeeper.DispatchActions(ctx, stranger, []sdk.Msg{msg})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.False(t, app.cheqdKeeper.HasDid(&ctx, did))
+	})
+
+	t.Run("Not valid: grantee submitting as itself", func(t *testing.T) {
+		msg := *msg
+		msg.Signer = grantee.String()
+
+		_, err := app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{&msg})
+		require.ErrorIs(t, err, cheqdtypes.ErrUnexpectedSigner)
+		require.False(t, app.cheqdKeeper.HasDid(&ctx, did))
+	})
+
+	t.Run("Valid: grantee", func(t *testing.T) {
+		_, err := app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+		require.NoError(t, err)
+		require.True(t, app.cheqdKeeper.HasDid(&ctx, did))
+	})
+}
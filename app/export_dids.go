@@ -0,0 +1,50 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// ExportDids writes the identity store as NDJSON: one JSON encoded StateValue per line.
+// DIDs are read from the store one by one, so the store is never loaded into memory as a whole.
+func (app *App) ExportDids(writer io.Writer) error {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	buffered := bufio.NewWriter(writer)
+
+	var err error
+	app.cheqdKeeper.IterateDids(&ctx, func(stateValue cheqdtypes.StateValue) (stop bool) {
+		var bz []byte
+		bz, err = app.appCodec.MarshalJSON(&stateValue)
+		if err != nil {
+			return true
+		}
+
+		_, err = buffered.Write(append(bz, '\n'))
+		return err != nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return buffered.Flush()
+}
+
+// ExportDidSnapshot writes a DID snapshot of the last committed height.
+// The snapshot can be verified offline with VerifyDidSnapshot.
+func (app *App) ExportDidSnapshot(writer io.Writer) error {
+	height := app.LastBlockHeight()
+	ctx := app.NewContext(true, tmproto.Header{Height: height})
+
+	store, ok := app.CommitMultiStore().(sdk.Queryable)
+	if !ok {
+		return fmt.Errorf("multistore doesn't support queries")
+	}
+
+	return app.cheqdKeeper.ExportDidSnapshot(&ctx, store, height, writer)
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	cheqdkeeper "github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibcconnectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// setV05VersionMap stores module versions of a chain running v0.5: x/cheqd is at version 3 and x/wasm isn't there
+func setV05VersionMap(app *App, ctx sdk.Context) {
+	versions := app.mm.GetVersionMap()
+	versions[cheqdtypes.ModuleName] = 3
+	delete(versions, wasm.ModuleName)
+
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, versions)
+}
+
+func TestUpgradeV06BindsIBCPort(t *testing.T) {
+	// The store of an existing chain: cheqd InitGenesis has never run, so the port isn't claimed
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0,
+		MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: 1})
+	app.CapabilityKeeper.InitMemStore(ctx)
+
+	require.False(t, app.cheqdKeeper.IsBound(ctx, cheqdtypes.PortID))
+	setV05VersionMap(app, ctx)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v0.6", Height: 1})
+
+	require.True(t, app.cheqdKeeper.IsBound(ctx, cheqdtypes.PortID))
+	require.Equal(t, cheqdtypes.PortID, app.cheqdKeeper.GetPort(ctx))
+
+	// Existing chains keep the authentication fallback
+	require.True(t, app.cheqdKeeper.GetParams(ctx).AuthenticationFallback)
+
+	// x/wasm is added by the upgrade
+	require.Equal(t, wasm.DefaultParams(), app.WasmKeeper.GetParams(ctx))
+
+	// Modules are at their current versions
+	require.Equal(t, app.mm.GetVersionMap(), module.VersionMap(app.UpgradeKeeper.GetModuleVersionMap(ctx)))
+
+	// The port is claimed only once
+	require.NoError(t, cheqdkeeper.NewMigrator(app.cheqdKeeper).Migrate3to4(ctx))
+}
+
+func TestUpgradeV06KeepsIBCState(t *testing.T) {
+	// ibc-go v1 and v3 share the store layout, params and consensus versions, so the upgrade
+	// must leave the IBC state of an existing chain as is
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0,
+		MakeEncodingConfig(), simapp.EmptyAppOptions{})
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: 1})
+	app.CapabilityKeeper.InitMemStore(ctx)
+
+	clientParams := ibcclienttypes.NewParams("07-tendermint")
+	connectionParams := ibcconnectiontypes.NewParams(60_000_000_000)
+	transferParams := ibctransfertypes.NewParams(false, true)
+	denomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+
+	app.IBCKeeper.ClientKeeper.SetParams(ctx, clientParams)
+	app.IBCKeeper.ConnectionKeeper.SetParams(ctx, connectionParams)
+	app.TransferKeeper.SetParams(ctx, transferParams)
+	app.TransferKeeper.SetDenomTrace(ctx, denomTrace)
+	setV05VersionMap(app, ctx)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v0.6", Height: 1})
+
+	require.Equal(t, clientParams, app.IBCKeeper.ClientKeeper.GetParams(ctx))
+	require.Equal(t, connectionParams, app.IBCKeeper.ConnectionKeeper.GetParams(ctx))
+	require.Equal(t, transferParams, app.TransferKeeper.GetParams(ctx))
+	require.True(t, app.TransferKeeper.HasDenomTrace(ctx, denomTrace.Hash()))
+
+	versions := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, uint64(2), versions[ibchost.ModuleName])
+	require.Equal(t, uint64(1), versions[ibctransfertypes.ModuleName])
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cheqd/cheqd-node/app"
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// ExportDidSnapshotCmd returns export-did-snapshot cobra Command.
+func ExportDidSnapshotCmd(a appCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-did-snapshot",
+		Short: "Export all DID Docs with proofs against the app hash",
+		Long: `Export a DID snapshot: all DID Docs and their count at a height with ICS-23 proofs against the cheqd store hash,
+followed by the proof of the store hash against the app hash. The snapshot can be verified offline
+with verify-did-snapshot command against the app hash from the header of the block next to the snapshot height.
+The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return exportFromApp(cmd, a, func(anApp *app.App, writer io.Writer) error {
+				return anApp.ExportDidSnapshot(writer)
+			})
+		},
+	}
+
+	addExportFlags(cmd, defaultNodeHome, "The snapshot will be written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// VerifyDidSnapshotCmd returns verify-did-snapshot cobra Command.
+func VerifyDidSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-did-snapshot [snapshot-file] [app-hash]",
+		Short: "Verify a DID snapshot against a trusted app hash",
+		Long: `Verify a DID snapshot exported with export-did-snapshot command. The app hash of the state at the snapshot height
+is contained in the header of the next block. Optionally, verified DID Docs are written as NDJSON, the same way
+as export-dids command writes them.
+`,
+		Example: "cheqd-noded verify-did-snapshot dids.snapshot 5F2A...9C1D --output-document dids.ndjson",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %w", err)
+			}
+
+			outputDoc, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			var output *bufio.Writer
+
+			if outputDoc != "" {
+				outputFile, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+				if err != nil {
+					return err
+				}
+
+				defer outputFile.Close()
+				output = bufio.NewWriter(outputFile)
+			}
+
+			count := 0
+			header, err := cheqdtypes.VerifyDidSnapshot(bufio.NewReader(file), appHash, func(stateValue cheqdtypes.StateValue) error {
+				count++
+
+				if output == nil {
+					return nil
+				}
+
+				bz, err := clientCtx.Codec.MarshalJSON(&stateValue)
+				if err != nil {
+					return err
+				}
+
+				_, err = output.Write(append(bz, '\n'))
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("snapshot verification failed: %w", err)
+			}
+
+			if output != nil {
+				if err := output.Flush(); err != nil {
+					return err
+				}
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("Snapshot is valid. Height: %d, store hash: %X, DID Docs: %d\n", header.Height, header.StoreHash, count))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Verified DID Docs will be written to the given file as NDJSON")
+
+	return cmd
+}
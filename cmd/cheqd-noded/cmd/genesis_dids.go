@@ -0,0 +1,321 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cheqd/cheqd-node/app"
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Max length of a line with a single DID Doc in NDJSON files
+const maxDidLineSize = 16 * 1024 * 1024
+
+// ExportDidsCmd returns export-dids cobra Command.
+func ExportDidsCmd(a appCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-dids",
+		Short: "Export the identity store as NDJSON",
+		Long: `Export all DID Docs with their metadata as NDJSON: one JSON encoded StateValue per line.
+DIDs are streamed from the store, so the command works for stores that don't fit into memory.
+The output can be added to the genesis of a fork with add-genesis-dids command.
+The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return exportFromApp(cmd, a, func(anApp *app.App, writer io.Writer) error {
+				return anApp.ExportDids(writer)
+			})
+		},
+	}
+
+	addExportFlags(cmd, defaultNodeHome, "The DIDs will be written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// AddGenesisDidsCmd returns add-genesis-dids cobra Command.
+func AddGenesisDidsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-dids [ndjson-file]",
+		Short: "Add DIDs exported with export-dids to genesis.json",
+		Long: `Add DIDs exported with export-dids command to genesis.json. The file is read line by line,
+each DID Doc is validated the same way the ledger validates new DIDs, using the params of the genesis.
+Controllers of the DIDs must be present in the genesis after the import.
+The file is read twice: to validate the DIDs and to write them to genesis.json, so this command never loads
+the DIDs into memory as a whole. Note that the node still unmarshals the whole genesis state on InitChain.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var cheqdGenState cheqdtypes.GenesisState
+			if err := cdc.UnmarshalJSON(appState[cheqdtypes.ModuleName], &cheqdGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal cheqd genesis state: %w", err)
+			}
+
+			if err := cheqdGenState.Validate(); err != nil {
+				return fmt.Errorf("failed to validate cheqd genesis state: %w", err)
+			}
+
+			if err := validateGenesisDids(cdc, args[0], cheqdGenState); err != nil {
+				return err
+			}
+
+			return writeGenesisWithDids(cdc, genFile, genDoc, appState, cheqdGenState, args[0])
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// scanDids calls handle for each DID of the NDJSON file
+func scanDids(cdc codec.JSONCodec, path string, handle func(line int, stateValue *cheqdtypes.StateValue) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxDidLineSize)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var stateValue cheqdtypes.StateValue
+		if err := cdc.UnmarshalJSON(scanner.Bytes(), &stateValue); err != nil {
+			return fmt.Errorf("failed to unmarshal DID at line %d: %w", line, err)
+		}
+
+		if err := handle(line, &stateValue); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// validateGenesisDids checks the DIDs of the NDJSON file against the genesis state they are added to.
+// Only ids and controllers of the DIDs are kept in memory.
+func validateGenesisDids(cdc codec.JSONCodec, path string, genState cheqdtypes.GenesisState) error {
+	var allowedNamespaces []string
+	if genState.DidNamespace != "" {
+		allowedNamespaces = []string{genState.DidNamespace}
+	}
+
+	params := genState.GetParamsOrDefault()
+
+	didIdMap := make(map[string]bool)
+	for _, elem := range genState.DidList {
+		did, err := elem.UnpackDataAsDid()
+		if err != nil {
+			return err
+		}
+
+		didIdMap[did.Id] = true
+	}
+
+	// Controller -> DID it controls
+	controllers := make(map[string]string)
+
+	err := scanDids(cdc, path, func(line int, stateValue *cheqdtypes.StateValue) error {
+		did, err := cheqdtypes.ValidateGenesisDid(stateValue, allowedNamespaces, params)
+		if err != nil {
+			return fmt.Errorf("invalid DID at line %d: %w", line, err)
+		}
+
+		if didIdMap[did.Id] {
+			return fmt.Errorf("duplicated id for did at line %d: %s", line, did.Id)
+		}
+
+		didIdMap[did.Id] = true
+
+		for _, controller := range did.AllControllerDids() {
+			controllers[controller] = did.Id
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Controllers must exist, as the ledger checks when DIDs are written
+	for controller, did := range controllers {
+		if !didIdMap[controller] {
+			return cheqdtypes.ErrDidDocNotFound.Wrapf("controller %s of %s", controller, did)
+		}
+	}
+
+	return nil
+}
+
+// writeGenesisWithDids writes the genesis file with DIDs of the NDJSON file added to the cheqd genesis state.
+// The file is written to a temporary location first and replaces the genesis file when complete.
+func writeGenesisWithDids(cdc codec.JSONCodec, genFile string, genDoc *tmtypes.GenesisDoc, appState map[string]json.RawMessage, cheqdGenState cheqdtypes.GenesisState, didsPath string) error {
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return err
+	}
+
+	// Everything except for the application state
+	genDoc.AppState = nil
+	genDocBz, err := tmjson.Marshal(genDoc)
+	if err != nil {
+		return fmt.Errorf("failed to marshal genesis doc: %w", err)
+	}
+
+	tmpFile := genFile + ".tmp"
+	file, err := os.OpenFile(tmpFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile)
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	if _, err := writer.Write(append(bytes.TrimSuffix(genDocBz, []byte("}")), []byte(`,"app_state":{`)...)); err != nil {
+		return err
+	}
+
+	modules := make([]string, 0, len(appState))
+	for module := range appState {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for i, module := range modules {
+		key, err := json.Marshal(module)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			key = append([]byte{','}, key...)
+		}
+
+		if _, err := writer.Write(append(key, ':')); err != nil {
+			return err
+		}
+
+		if module != cheqdtypes.ModuleName {
+			if _, err := writer.Write(appState[module]); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		gw, err := cheqdtypes.NewGenesisJSONWriter(cdc, writer, cheqdGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal cheqd genesis state: %w", err)
+		}
+
+		err = scanDids(cdc, didsPath, func(_ int, stateValue *cheqdtypes.StateValue) error {
+			return gw.WriteDid(stateValue)
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := gw.Close(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := writer.Write([]byte("}}\n")); err != nil {
+		return err
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile, genFile)
+}
+
+func addExportFlags(cmd *cobra.Command, defaultNodeHome string, outputDocumentUsage string) {
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", outputDocumentUsage)
+}
+
+// exportFromApp loads the application state of a stopped node and calls export with the output document or STDOUT
+func exportFromApp(cmd *cobra.Command, a appCreator, export func(anApp *app.App, writer io.Writer) error) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	height, err := cmd.Flags().GetInt64(server.FlagHeight)
+	if err != nil {
+		return err
+	}
+
+	outputDoc, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if err != nil {
+		return err
+	}
+
+	db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	anApp, err := a.loadApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+	if err != nil {
+		return fmt.Errorf("failed to load application state: %w", err)
+	}
+
+	var writer io.Writer = cmd.OutOrStdout()
+
+	if outputDoc != "" {
+		file, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+		writer = file
+	}
+
+	return export(anApp, writer)
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	cheqdkeys "github.com/cheqd/cheqd-node/x/cheqd/client/keys"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+func extendKeys(keysCmd *cobra.Command) *cobra.Command {
+	keysCmd.AddCommand(addIdentityKeyCmd(),
+		importIdentityKeyCmd(),
+		showIdentityKeyCmd())
+
+	return keysCmd
+}
+
+// identityKeyring opens the keyring of the command with identity key algorithms enabled.
+// The account keyring doesn't support them, so they can't be chosen for account keys.
+func identityKeyring(cmd *cobra.Command, clientCtx client.Context) (keyring.Keyring, error) {
+	backend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewKeyringFromBackend(clientCtx.WithKeyringOptions(cheqdkeys.KeyringOption()), backend)
+}
+
+// addIdentityKeyCmd returns cobra Command.
+func addIdentityKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-identity [name] [ed25519|secp256k1|p256]",
+		Short: "Generate an identity key and store it in the keyring",
+		Long: "Generate an identity key from a new mnemonic and store it in the keyring. " +
+			"Identity keys sign identity payloads with --sign-with flag and can't be used as account keys, " +
+			"except for secp256k1 keys. The mnemonic is printed to STDERR.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			kr, err := identityKeyring(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			if _, err := kr.Key(name); err == nil {
+				return fmt.Errorf("key %s already exists", name)
+			}
+
+			keyringAlgos, _ := kr.SupportedAlgorithms()
+			algo, err := keyring.NewSigningAlgoFromString(args[1], keyringAlgos)
+			if err != nil {
+				return err
+			}
+
+			info, mnemonic, err := kr.NewMnemonic(name, keyring.English, sdk.GetConfig().GetFullBIP44Path(), keyring.DefaultBIP39Passphrase, algo)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.ErrOrStderr(), "\n**Important** write this mnemonic phrase in a safe place.\n%s\n\n", mnemonic)
+			if err != nil {
+				return err
+			}
+
+			return printIdentityKey(cmd, info)
+		},
+	}
+
+	return cmd
+}
+
+// importIdentityKeyCmd returns cobra Command.
+func importIdentityKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-identity [name] [ed25519|secp256k1|p256]",
+		Short: "Import a raw identity private key into the keyring",
+		Long: "Import a raw identity private key into the keyring. " +
+			"The base64 encoded private key and a passphrase to encrypt it for the import are read from the prompt, " +
+			"or line by line from STDIN, so they don't leak into shell history. " +
+			"New identity keys can be generated with 'keys add-identity [name] [ed25519|secp256k1|p256]'.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			algo := hd.PubKeyType(args[1])
+
+			inBuf := bufio.NewReader(clientCtx.Input)
+			privKeyBase64, err := input.GetPassword("Enter base64 encoded private key:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			privKeyBytes, err := base64.StdEncoding.DecodeString(privKeyBase64)
+			if err != nil {
+				return fmt.Errorf("unable to decode private key: %s", err.Error())
+			}
+
+			privKey, err := cheqdkeys.PrivKeyFromBytes(algo, privKeyBytes)
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to encrypt the key for the import:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			armor := crypto.EncryptArmorPrivKey(privKey, passphrase, string(algo))
+			return clientCtx.Keyring.ImportPrivKey(name, armor, passphrase)
+		},
+	}
+
+	return cmd
+}
+
+// showIdentityKeyCmd returns cobra Command.
+func showIdentityKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-identity [name]",
+		Short: "Show the public part of an identity key in verification method formats",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			info, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+
+			return printIdentityKey(cmd, info)
+		},
+	}
+
+	return cmd
+}
+
+// printIdentityKey prints the public part of the key in the forms verification methods can use
+func printIdentityKey(cmd *cobra.Command, info keyring.Info) error {
+	// Multibase form is only available for ed25519 and secp256k1 keys, JWK form for ed25519 and p256 keys
+	pubKeyMultibase, multibaseErr := cheqdkeys.PubKeyMultibase(info.GetPubKey())
+	pubKeyJwk, jwkErr := cheqdkeys.PubKeyJWK(info.GetPubKey())
+	if multibaseErr != nil && jwkErr != nil {
+		return jwkErr
+	}
+
+	keyInfo := struct {
+		Algo               string                `json:"algo"`
+		PublicKeyMultibase string                `json:"public_key_multibase,omitempty"`
+		PublicKeyJwk       []*types.KeyValuePair `json:"public_key_jwk,omitempty"`
+	}{
+		Algo:               string(info.GetAlgo()),
+		PublicKeyMultibase: pubKeyMultibase,
+		PublicKeyJwk:       pubKeyJwk,
+	}
+
+	keyInfoJson, err := json.Marshal(keyInfo)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(keyInfoJson))
+	return err
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/client/cli"
+	"github.com/cheqd/cheqd-node/x/cheqd/client/resolver"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/light"
+	dbs "github.com/tendermint/tendermint/light/store/db"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	FlagWitnesses      = cli.FlagWitnesses
+	FlagTrustedHeight  = cli.FlagTrustedHeight
+	FlagTrustedHash    = cli.FlagTrustedHash
+	FlagTrustingPeriod = cli.FlagTrustingPeriod
+	FlagListenAddress  = "listen-address"
+	FlagUpdateInterval = "update-interval"
+	FlagTLSCertFile    = "tls-cert-file"
+	FlagTLSKeyFile     = "tls-key-file"
+)
+
+// ResolverCmd returns resolver cobra Command.
+func ResolverCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolver",
+		Short: "Run a DID resolver verifying responses of an untrusted node",
+		Long: `Run a local HTTP(S) DID resolver backed by a Tendermint light client. Headers of the node are verified
+by the light client and cross-checked with witnesses. DID Docs and their absence are verified by ICS-23 proofs
+against the app hashes of the verified headers.
+
+DIDs are resolved with the DID Resolution HTTP(S) binding: GET /1.0/identifiers/{did}.
+
+On the first start, the light client must be initialized with a trusted height and the hash of its header,
+obtained from a source you trust. Trusted headers are stored in the home directory for later starts.
+`,
+		Example: `cheqd-noded resolver --chain-id cheqd-mainnet-1 --node https://rpc.cheqd.net:443 \
+  --witnesses https://rpc.cheqd.example:443 --trusted-height 1000 --trusted-hash 2B2A...5E17`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			logger := serverCtx.Logger.With("module", "resolver")
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			node, _ := cmd.Flags().GetString(flags.FlagNode)
+			chainId, _ := cmd.Flags().GetString(flags.FlagChainID)
+			witnesses, _ := cmd.Flags().GetStringSlice(FlagWitnesses)
+			trustedHeight, _ := cmd.Flags().GetInt64(FlagTrustedHeight)
+			trustedHash, _ := cmd.Flags().GetString(FlagTrustedHash)
+			trustingPeriod, _ := cmd.Flags().GetDuration(FlagTrustingPeriod)
+			listenAddress, _ := cmd.Flags().GetString(FlagListenAddress)
+			updateInterval, _ := cmd.Flags().GetDuration(FlagUpdateInterval)
+			tlsCertFile, _ := cmd.Flags().GetString(FlagTLSCertFile)
+			tlsKeyFile, _ := cmd.Flags().GetString(FlagTLSKeyFile)
+
+			if chainId == "" {
+				return errors.New("chain id is required")
+			}
+
+			if len(witnesses) == 0 {
+				return errors.New("at least one witness is required")
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			db, err := dbm.NewGoLevelDB("resolver-light-client", filepath.Join(homeDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			trustedStore := dbs.New(db, chainId)
+			options := []light.Option{light.Logger(logger.With("module", "light"))}
+
+			var lightClient *light.Client
+			if trustedHeight > 0 {
+				hash, err := hex.DecodeString(trustedHash)
+				if err != nil {
+					return fmt.Errorf("invalid trusted hash: %w", err)
+				}
+
+				trustOptions := light.TrustOptions{Period: trustingPeriod, Height: trustedHeight, Hash: hash}
+				lightClient, err = light.NewHTTPClient(ctx, chainId, trustOptions, node, witnesses, trustedStore, options...)
+				if err != nil {
+					return err
+				}
+			} else {
+				if height, err := trustedStore.LastLightBlockHeight(); err != nil || height <= 0 {
+					return errors.New("no trusted headers are stored, trusted height and hash are required")
+				}
+
+				lightClient, err = light.NewHTTPClientFromTrustedStore(chainId, trustingPeriod, node, witnesses, trustedStore, options...)
+				if err != nil {
+					return err
+				}
+			}
+
+			rpcClient, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return err
+			}
+
+			didResolver := resolver.NewResolver(lightClient, rpcClient, logger)
+			if err := didResolver.Update(ctx); err != nil {
+				return fmt.Errorf("failed to verify the latest header: %w", err)
+			}
+
+			go didResolver.Run(ctx, updateInterval)
+
+			srv := &http.Server{Addr: listenAddress, Handler: didResolver}
+			go func() {
+				<-ctx.Done()
+
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				_ = srv.Shutdown(shutdownCtx)
+			}()
+
+			logger.Info("starting DID resolver", "address", listenAddress)
+
+			if tlsCertFile != "" {
+				err = srv.ListenAndServeTLS(tlsCertFile, tlsKeyFile)
+			} else {
+				err = srv.ListenAndServe()
+			}
+
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory, trusted headers are stored in it")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "RPC address of the primary node")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "RPC addresses of witness nodes, used to detect forks")
+	cmd.Flags().Int64(FlagTrustedHeight, 0, "Trusted header height, required on the first start")
+	cmd.Flags().String(FlagTrustedHash, "", "Hex encoded hash of the trusted header")
+	cmd.Flags().Duration(FlagTrustingPeriod, 168*time.Hour, "Trusting period, must be less than the unbonding period")
+	cmd.Flags().String(FlagListenAddress, "localhost:8088", "Address the resolver listens on")
+	cmd.Flags().Duration(FlagUpdateInterval, 5*time.Second, "Interval of verifying the latest header")
+	cmd.Flags().String(FlagTLSCertFile, "", "TLS certificate file, enables HTTPS")
+	cmd.Flags().String(FlagTLSKeyFile, "", "TLS key file")
+
+	return cmd
+}
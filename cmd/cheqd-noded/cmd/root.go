@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/cheqd/cheqd-node/app/params"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 
@@ -100,18 +101,26 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	a := appCreator{encodingConfig}
 	server.AddCommands(rootCmd, app.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	rootCmd.AddCommand(
+		ExportDidsCmd(a, app.DefaultNodeHome),
+		AddGenesisDidsCmd(app.DefaultNodeHome),
+		ExportDidSnapshotCmd(a, app.DefaultNodeHome),
+		VerifyDidSnapshotCmd(),
+		ResolverCmd(app.DefaultNodeHome),
+	)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
-		keys.Commands(app.DefaultNodeHome),
+		extendKeys(keys.Commands(app.DefaultNodeHome)),
 	)
 }
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	wasm.AddModuleInitFlags(startCmd)
 	// this line is used by starport scaffolding # stargate/root/initFlags
 }
 
@@ -225,11 +234,21 @@ func (a appCreator) appExport(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string,
 	appOpts servertypes.AppOptions,
 ) (servertypes.ExportedApp, error) {
+	anApp, err := a.loadApp(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return anApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+}
+
+// loadApp creates an app for reading the state (optionally at a given height)
+func (a appCreator) loadApp(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, appOpts servertypes.AppOptions) (*app.App, error) {
 	var anApp *app.App
 
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home not set")
+		return nil, errors.New("application home not set")
 	}
 
 	if height != -1 {
@@ -247,7 +266,7 @@ func (a appCreator) appExport(
 		)
 
 		if err := anApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
+			return nil, err
 		}
 	} else {
 		anApp = app.New(
@@ -264,7 +283,7 @@ func (a appCreator) appExport(
 		)
 	}
 
-	return anApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+	return anApp, nil
 }
 
 func overwriteFlagDefaults(c *cobra.Command, defaults map[string]string) {
@@ -0,0 +1,45 @@
+#!/bin/bash
+
+set -euox pipefail
+
+SCRIPT_DIR="$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+# shellcheck source=/dev/null
+source "$SCRIPT_DIR/common.sh"
+
+
+# Generating identity key in the keyring
+KEY_NAME="identity_key_$(random_string 8)"
+# shellcheck disable=SC2086
+cheqd-noded keys add "${KEY_NAME}" --algo ed25519 ${KEYS_PARAMS}
+
+# Building DID document
+# shellcheck disable=SC2086
+MSG_CREATE_DID=$(cheqd-noded tx cheqd did new --namespace testnet --key "${KEY_NAME}" \
+  --service "linked-domain,LinkedDomains,https://example.com" ${KEYS_PARAMS})
+
+DID=$(echo "${MSG_CREATE_DID}" | jq -r ".id")
+KEY_ID="${DID}#key-1"
+
+assert_eq "$(echo "${MSG_CREATE_DID}" | jq -r ".authentication[0]")" "${KEY_ID}"
+assert_eq "$(echo "${MSG_CREATE_DID}" | jq -r ".assertion_method[0]")" "${KEY_ID}"
+
+# Post the message signed with the key from the keyring
+# shellcheck disable=SC2086
+RESULT=$(cheqd-noded tx cheqd create-did "${MSG_CREATE_DID}" --sign-with "${KEY_ID}=${KEY_NAME}" \
+  --from "${BASE_ACCOUNT_1}" ${TX_PARAMS})
+
+assert_tx_successful "$RESULT"
+
+# Building and broadcasting DID document in one step
+# shellcheck disable=SC2086
+RESULT=$(cheqd-noded tx cheqd did new --namespace testnet --key "${KEY_NAME}" --broadcast \
+  --from "${BASE_ACCOUNT_1}" ${TX_PARAMS})
+
+assert_tx_successful "$RESULT"
+
+
+# Query DID
+# shellcheck disable=SC2086
+RESULT=$(cheqd-noded query cheqd did "${DID}" ${QUERY_PARAMS})
+
+assert_eq "$(echo "$RESULT" | jq -r ".did.service[0].id")" "${DID}#linked-domain"
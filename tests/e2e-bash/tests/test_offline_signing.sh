@@ -0,0 +1,54 @@
+#!/bin/bash
+
+set -euox pipefail
+
+SCRIPT_DIR="$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+# shellcheck source=/dev/null
+source "$SCRIPT_DIR/common.sh"
+
+
+# Generating keys
+ALICE_VER_KEY="$(cheqd-noded debug ed25519 random)"
+ALICE_VER_PUB_BASE_64=$(echo "${ALICE_VER_KEY}" | jq -r ".pub_key_base_64")
+ALICE_VER_PRIV_BASE_64=$(echo "${ALICE_VER_KEY}" | jq -r ".priv_key_base_64")
+ALICE_VER_PUB_MULTIBASE_58=$(cheqd-noded debug encoding base64-multibase58 "${ALICE_VER_PUB_BASE_64}")
+
+DID="did:cheqd:testnet:$(random_string)"
+KEY_ID="${DID}#key1"
+
+MSG_CREATE_DID='{
+  "id": "'${DID}'",
+  "verification_method": [{
+    "id": "'${KEY_ID}'",
+    "type": "Ed25519VerificationKey2020",
+    "controller": "'${DID}'",
+    "public_key_multibase": "'${ALICE_VER_PUB_MULTIBASE_58}'"
+  }],
+  "authentication": [
+    "'${KEY_ID}'"
+  ]
+}';
+
+SIGNATURE_FILE=$(mktemp)
+
+# Sign the payload without connection to a node (can be done on an air-gapped machine)
+cheqd-noded tx cheqd sign-payload create "${MSG_CREATE_DID}" "${KEY_ID}" "${ALICE_VER_PRIV_BASE_64}" \
+  --output-document "${SIGNATURE_FILE}"
+
+assert_eq "$(jq -r ".verification_method_id" "${SIGNATURE_FILE}")" "${KEY_ID}"
+
+# Assemble and broadcast the message
+# shellcheck disable=SC2086
+RESULT=$(cheqd-noded tx cheqd assemble create "${MSG_CREATE_DID}" "${SIGNATURE_FILE}" \
+  --from "${BASE_ACCOUNT_1}" ${TX_PARAMS})
+
+assert_tx_successful "$RESULT"
+
+rm "${SIGNATURE_FILE}"
+
+
+# Query DID
+# shellcheck disable=SC2086
+RESULT=$(cheqd-noded query cheqd did "${DID}" ${QUERY_PARAMS})
+
+assert_eq "$(echo "$RESULT" | jq -r ".did.id")" "${DID}"
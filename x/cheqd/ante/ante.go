@@ -0,0 +1,78 @@
+package ante
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// IdentityMsgDecorator runs the stateful checks of cheqd messages during CheckTx:
+// namespace, DID existence, version id and identity signatures. Invalid identity
+// transactions are rejected before they reach the mempool.
+//
+// The checks are the ones the msg server applies in DeliverTx. Their results are written
+// to a cached context only, and DID hooks aren't called. Identity messages wrapped
+// into authz MsgExec are checked too.
+type IdentityMsgDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewIdentityMsgDecorator(k keeper.Keeper) IdentityMsgDecorator {
+	return IdentityMsgDecorator{
+		keeper: k,
+	}
+}
+
+func (d IdentityMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// Messages are executed anyway in DeliverTx and simulation. ReCheckTx is skipped to keep mempool rechecks cheap.
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	// Messages are applied one after another so that later messages can rely on earlier ones
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	if err := d.checkMsgs(&cacheCtx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d IdentityMsgDecorator) checkMsgs(ctx *sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *types.MsgCreateDid:
+			did, metadata, err := keeper.CheckCreateDid(&d.keeper, ctx, msg)
+			if err != nil {
+				return err
+			}
+
+			if err := d.keeper.AppendDid(ctx, did, metadata); err != nil {
+				return types.ErrInternal.Wrap(err.Error())
+			}
+		case *types.MsgUpdateDid:
+			_, did, metadata, err := keeper.CheckUpdateDid(&d.keeper, ctx, msg)
+			if err != nil {
+				return err
+			}
+
+			if err := d.keeper.SetDid(ctx, did, metadata); err != nil {
+				return types.ErrInternal.Wrap(err.Error())
+			}
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := d.checkMsgs(ctx, execMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
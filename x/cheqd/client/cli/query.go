@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdGetDid())
+	cmd.AddCommand(CmdGetParams())
 
 	return cmd
 }
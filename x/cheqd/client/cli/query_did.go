@@ -2,26 +2,77 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
 
+	"github.com/cheqd/cheqd-node/x/cheqd/client/verify"
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	dbs "github.com/tendermint/tendermint/light/store/db"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	FlagProve          = "prove"
+	FlagWitnesses      = "witnesses"
+	FlagTrustedHeight  = "trusted-height"
+	FlagTrustedHash    = "trusted-hash"
+	FlagTrustingPeriod = "trusting-period"
 )
 
 func CmdGetDid() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "did [id]",
 		Short: "Query a did",
-		Args:  cobra.ExactArgs(1),
+		Long: `Query a did. With --prove flag the response is verified by its ICS-23 proof against the app hash
+from the header of the next block. The header is verified by a light client starting from the trusted height
+and hash, obtained from a source you trust, and cross-checked with witnesses. The node being queried is never
+trusted for the header.
+Without --height flag the state of the block previous to the latest one is queried, as the latest state can't be
+proven until the next block is committed.`,
+		Example: `cheqd-noded query cheqd did did:cheqd:mainnet:zF7rhDBfUt9d1gJPjx7s1JXfUY7oVWkY --prove \
+  --chain-id cheqd-mainnet-1 --node https://rpc.cheqd.net:443 --witnesses https://rpc.cheqd.example:443 \
+  --trusted-height 1000 --trusted-hash 2B2A...5E17`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			prove, err := cmd.Flags().GetBool(FlagProve)
+			if err != nil {
+				return err
+			}
+
+			var lightClient *light.Client
+			if prove {
+				lightClient, err = newLightClient(cmd, clientCtx)
+				if err != nil {
+					return err
+				}
+
+				if clientCtx.Height == 0 {
+					height, err := getProvableHeight(cmd.Context(), clientCtx)
+					if err != nil {
+						return err
+					}
+
+					clientCtx = clientCtx.WithHeight(height)
+				}
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 
 			did := args[0]
 			params := &types.QueryGetDidRequest{
-				Id: did,
+				Id:    did,
+				Prove: prove,
 			}
 
 			resp, err := queryClient.Did(context.Background(), params)
@@ -29,11 +80,90 @@ func CmdGetDid() *cobra.Command {
 				return err
 			}
 
+			if prove {
+				lightBlock, err := lightClient.VerifyLightBlockAtHeight(cmd.Context(), resp.Height+1, time.Now())
+				if err != nil {
+					return fmt.Errorf("failed to verify the header at height %d: %w", resp.Height+1, err)
+				}
+
+				if err := verify.DidResponseWithHeader(resp, lightBlock.Header); err != nil {
+					return fmt.Errorf("response verification failed: %w", err)
+				}
+			}
+
 			return clientCtx.PrintProto(resp)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID, required with --prove flag")
+	cmd.Flags().Bool(FlagProve, false, "Verify the response by its proof against a header verified by a light client")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "RPC addresses of witness nodes, used to detect forks. Required with --prove flag")
+	cmd.Flags().Int64(FlagTrustedHeight, 0, "Trusted header height. Required with --prove flag")
+	cmd.Flags().String(FlagTrustedHash, "", "Hex encoded hash of the trusted header. Required with --prove flag")
+	cmd.Flags().Duration(FlagTrustingPeriod, 168*time.Hour, "Trusting period, must be less than the unbonding period")
 
 	return cmd
 }
+
+// newLightClient creates a light client for the node that trusts the header with the height and hash from flags.
+// Trusted headers are kept in memory only.
+func newLightClient(cmd *cobra.Command, clientCtx client.Context) (*light.Client, error) {
+	witnesses, err := cmd.Flags().GetStringSlice(FlagWitnesses)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedHeight, err := cmd.Flags().GetInt64(FlagTrustedHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedHash, err := cmd.Flags().GetString(FlagTrustedHash)
+	if err != nil {
+		return nil, err
+	}
+
+	trustingPeriod, err := cmd.Flags().GetDuration(FlagTrustingPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientCtx.ChainID == "" {
+		return nil, fmt.Errorf("--%s is required with --%s flag", flags.FlagChainID, FlagProve)
+	}
+
+	if len(witnesses) == 0 {
+		return nil, fmt.Errorf("at least one witness is required with --%s flag", FlagProve)
+	}
+
+	if trustedHeight <= 0 || trustedHash == "" {
+		return nil, fmt.Errorf("--%s and --%s are required with --%s flag", FlagTrustedHeight, FlagTrustedHash, FlagProve)
+	}
+
+	hash, err := hex.DecodeString(trustedHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted hash: %w", err)
+	}
+
+	trustOptions := light.TrustOptions{Period: trustingPeriod, Height: trustedHeight, Hash: hash}
+	trustedStore := dbs.New(dbm.NewMemDB(), clientCtx.ChainID)
+
+	return light.NewHTTPClient(cmd.Context(), clientCtx.ChainID, trustOptions, clientCtx.NodeURI, witnesses, trustedStore,
+		light.Logger(log.NewNopLogger()))
+}
+
+// getProvableHeight returns the latest height which state can be proven by the header of the next block
+func getProvableHeight(ctx context.Context, clientCtx client.Context) (int64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight - 1, nil
+}
@@ -0,0 +1,132 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/app"
+	"github.com/cheqd/cheqd-node/x/cheqd/client/cli"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const testDid = "did:cheqd:testnet:123456789abcdefg"
+
+func networkConfig(t *testing.T) network.Config {
+	encodingConfig := app.MakeEncodingConfig()
+
+	cfg := network.DefaultConfig()
+	cfg.Codec = encodingConfig.Codec
+	cfg.TxConfig = encodingConfig.TxConfig
+	cfg.LegacyAmino = encodingConfig.Amino
+	cfg.InterfaceRegistry = encodingConfig.InterfaceRegistry
+	cfg.GenesisState = app.ModuleBasics.DefaultGenesis(encodingConfig.Codec)
+	cfg.NumValidators = 1
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return app.New(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encodingConfig,
+			simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+
+	keyId := testDid + "#key-1"
+	did := types.Did{
+		Id: testDid,
+		VerificationMethod: []*types.VerificationMethod{
+			{
+				Id:                 keyId,
+				Type:               types.Ed25519VerificationKey2020,
+				Controller:         testDid,
+				PublicKeyMultibase: "zF1hVGXXK9rmx5HhMTpGnGQJiab9qrFJbQXBRhSmYjQWX",
+			},
+		},
+		Authentication: types.VerificationMethodReferences(keyId),
+	}
+
+	stateValue, err := types.NewStateValue(&did, &types.Metadata{Created: "2021-01-01T00:00:00Z", VersionId: "version"})
+	require.NoError(t, err)
+
+	genesis := types.DefaultGenesis()
+	genesis.DidList = []*types.StateValue{&stateValue}
+	cfg.GenesisState[types.ModuleName] = encodingConfig.Codec.MustMarshalJSON(genesis)
+
+	return cfg
+}
+
+func TestCmdGetDidProve(t *testing.T) {
+	net := network.New(t, networkConfig(t))
+	defer net.Cleanup()
+
+	_, err := net.WaitForHeight(3)
+	require.NoError(t, err)
+
+	val := net.Validators[0]
+
+	trustedHeight := int64(1)
+	commit, err := val.RPCClient.Commit(context.Background(), &trustedHeight)
+	require.NoError(t, err)
+
+	trustedHash := commit.Hash().String()
+	otherHeight := int64(2)
+	otherCommit, err := val.RPCClient.Commit(context.Background(), &otherHeight)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		args     []string
+		errorMsg string
+	}{
+		{
+			name: "Valid: header verified by the light client",
+			args: []string{"--witnesses", val.RPCAddress, "--trusted-height", "1", "--trusted-hash", trustedHash},
+		},
+		{
+			name:     "Not valid: no trusted header",
+			args:     []string{"--witnesses", val.RPCAddress},
+			errorMsg: "--trusted-height and --trusted-hash are required with --prove flag",
+		},
+		{
+			name:     "Not valid: no witnesses",
+			args:     []string{"--trusted-height", "1", "--trusted-hash", trustedHash},
+			errorMsg: "at least one witness is required with --prove flag",
+		},
+		{
+			name:     "Not valid: trusted hash doesn't match the header",
+			args:     []string{"--witnesses", val.RPCAddress, "--trusted-height", "1", "--trusted-hash", otherCommit.Hash().String()},
+			errorMsg: "expected header's hash",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := append([]string{
+				testDid, "--prove",
+				fmt.Sprintf("--%s=%s", flags.FlagNode, val.RPCAddress),
+				fmt.Sprintf("--%s=%s", flags.FlagChainID, net.Config.ChainID),
+				fmt.Sprintf("--%s=json", "output"),
+			}, tc.args...)
+
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdGetDid(), args)
+
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				require.Contains(t, out.String(), testDid)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	cheqdkeys "github.com/cheqd/cheqd-node/x/cheqd/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/lestrrat-go/jwx/jwk"
+)
+
+// IdentitySigner produces signatures of identity payloads
+type IdentitySigner interface {
+	Sign(msg []byte) ([]byte, error)
+}
+
+// Ed25519Signer signs with a raw ed25519 private key
+type Ed25519Signer ed25519.PrivateKey
+
+func (s Ed25519Signer) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(ed25519.PrivateKey(s), msg), nil
+}
+
+// RSASigner uses PSS padding and SHA256 digest, the same as the ledger's verification does
+type RSASigner struct {
+	key *rsa.PrivateKey
+}
+
+func (s RSASigner) Sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+	return rsa.SignPSS(rand.Reader, s.key, crypto.SHA256, digest[:], nil)
+}
+
+// ECDSASigner uses SHA256 digest and ASN1 encoding of r and s, the same as the ledger's verification does
+type ECDSASigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s ECDSASigner) Sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+	return ecdsa.SignASN1(rand.Reader, s.key, digest[:])
+}
+
+// KeyringSigner signs with a key stored in the keyring
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	keyName string
+}
+
+func (s KeyringSigner) Sign(msg []byte) ([]byte, error) {
+	return cheqdkeys.Sign(s.keyring, s.keyName, msg)
+}
+
+// ParsePrivateKey detects the type and the encoding of a private key and returns the matching signer.
+// Supported encodings are:
+// - base64 encoded raw ed25519 private key;
+// - PEM encoded PKCS#8, PKCS#1 (RSA) or SEC 1 (EC) private key;
+// - base64 encoded PKCS#8 DER private key;
+// - JWK private key.
+// If the value starts with '@', the key is read from the file with the given path.
+func ParsePrivateKey(value string) (IdentitySigner, error) {
+	if strings.HasPrefix(value, "@") {
+		bytes, err := os.ReadFile(strings.TrimPrefix(value, "@"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read private key: %s", err.Error())
+		}
+
+		value = string(bytes)
+	}
+
+	value = strings.TrimSpace(value)
+
+	switch {
+	case strings.HasPrefix(value, "-----BEGIN"):
+		block, _ := pem.Decode([]byte(value))
+		if block == nil {
+			return nil, fmt.Errorf("unable to decode PEM private key")
+		}
+
+		return parseDERPrivateKey(block.Bytes)
+
+	case strings.HasPrefix(value, "{"):
+		var raw interface{}
+		err := jwk.ParseRawKey([]byte(value), &raw)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse JWK private key: %s", err.Error())
+		}
+
+		return signerFromKey(raw)
+
+	default:
+		bytes, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode private key: %s", err.Error())
+		}
+
+		// Raw ed25519 keys are kept for backward compatibility
+		if len(bytes) == ed25519.PrivateKeySize {
+			return Ed25519Signer(bytes), nil
+		}
+
+		return parseDERPrivateKey(bytes)
+	}
+}
+
+func parseDERPrivateKey(der []byte) (IdentitySigner, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return signerFromKey(key)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return signerFromKey(key)
+	}
+
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return signerFromKey(key)
+	}
+
+	return nil, fmt.Errorf("unable to parse private key: unknown format. supported formats are: PKCS#8, PKCS#1, SEC 1")
+}
+
+func signerFromKey(key interface{}) (IdentitySigner, error) {
+	switch key := key.(type) {
+	case ed25519.PrivateKey:
+		return Ed25519Signer(key), nil
+	case *ed25519.PrivateKey:
+		return Ed25519Signer(*key), nil
+	case *rsa.PrivateKey:
+		return RSASigner{key: key}, nil
+	case *ecdsa.PrivateKey:
+		return ECDSASigner{key: key}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type: %T. supported types are: ed25519, rsa, ecdsa", key)
+	}
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/stretchr/testify/require"
+)
+
+func EncodePKCS8PEM(t *testing.T, key interface{}) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func EncodeJWK(t *testing.T, key interface{}) string {
+	jwkKey, err := jwk.New(key)
+	require.NoError(t, err)
+	bytes, err := json.Marshal(jwkKey)
+	require.NoError(t, err)
+	return string(bytes)
+}
+
+func PubKeyToVerificationMethod(t *testing.T, pubKey crypto.PublicKey) types.VerificationMethod {
+	jwkKey, err := jwk.New(pubKey)
+	require.NoError(t, err)
+	bytes, err := json.Marshal(jwkKey)
+	require.NoError(t, err)
+
+	var fields map[string]string
+	require.NoError(t, json.Unmarshal(bytes, &fields))
+
+	vm := types.VerificationMethod{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa#key-1", Type: types.JsonWebKey2020}
+	for key, value := range fields {
+		vm.PublicKeyJwk = append(vm.PublicKeyJwk, &types.KeyValuePair{Key: key, Value: value})
+	}
+
+	return vm
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	ed25519PubKey, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	sec1, err := x509.MarshalECPrivateKey(p256Key)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(p384Key)
+	require.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(keyFile, []byte(EncodePKCS8PEM(t, rsaKey)), 0o600))
+
+	cases := []struct {
+		name   string
+		value  string
+		pubKey crypto.PublicKey
+	}{
+		{"Valid: raw ed25519", base64.StdEncoding.EncodeToString(ed25519Key), ed25519PubKey},
+		{"Valid: PKCS#8 PEM ed25519", EncodePKCS8PEM(t, ed25519Key), ed25519PubKey},
+		{"Valid: PKCS#8 PEM RSA", EncodePKCS8PEM(t, rsaKey), &rsaKey.PublicKey},
+		{"Valid: PKCS#1 PEM RSA", string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})), &rsaKey.PublicKey},
+		{"Valid: SEC 1 PEM P-256", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1})), &p256Key.PublicKey},
+		{"Valid: PKCS#8 DER P-384", base64.StdEncoding.EncodeToString(pkcs8), &p384Key.PublicKey},
+		{"Valid: JWK RSA", EncodeJWK(t, rsaKey), &rsaKey.PublicKey},
+		{"Valid: JWK P-256", EncodeJWK(t, p256Key), &p256Key.PublicKey},
+		{"Valid: file", "@" + keyFile, &rsaKey.PublicKey},
+	}
+
+	msg := []byte("payload")
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			signer, err := ParsePrivateKey(tc.value)
+			require.NoError(t, err)
+
+			signature, err := signer.Sign(msg)
+			require.NoError(t, err)
+
+			vm := PubKeyToVerificationMethod(t, tc.pubKey)
+			require.NoError(t, types.VerifySignature(vm, msg, signature))
+		})
+	}
+
+	t.Run("Not valid: public key", func(t *testing.T) {
+		_, err := ParsePrivateKey(EncodeJWK(t, &rsaKey.PublicKey))
+		require.Error(t, err)
+	})
+
+	t.Run("Not valid: unknown format", func(t *testing.T) {
+		_, err := ParsePrivateKey(base64.StdEncoding.EncodeToString([]byte("not a key")))
+		require.Error(t, err)
+	})
+}
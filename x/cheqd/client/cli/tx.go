@@ -2,22 +2,51 @@ package cli
 
 import (
 	"bufio"
-	"crypto/ed25519"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 )
 
+const (
+	PayloadTypeCreateDid = "create"
+	PayloadTypeUpdateDid = "update"
+)
+
+// IdentityPayload is implemented by payloads of identity messages
+type IdentityPayload interface {
+	codec.ProtoMarshaler
+	GetSignBytes() []byte
+	GetSignBytesForMode(mode types.SignMode) ([]byte, error)
+}
+
+const FlagSignWith = "sign-with"
+
+const (
+	FlagPayloadSignMode = "payload-sign-mode"
+	SignModeBinary      = "binary"
+	SignModeJCS         = "jcs"
+)
+
+const FlagSigner = "signer"
+
+const (
+	FlagPayloadChainId      = "payload-chain-id"
+	FlagPayloadExpiryHeight = "payload-expiry-height"
+	FlagPayloadExpiryTime   = "payload-expiry-time"
+)
+
 type SignInput struct {
 	verificationMethodId string
-	privKey              ed25519.PrivateKey
+	signer               IdentitySigner
 }
 
 // GetTxCmd returns the transaction commands for this module
@@ -32,6 +61,12 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdCreateDid())
 	cmd.AddCommand(CmdUpdateDid())
+	cmd.AddCommand(CmdSignPayload())
+	cmd.AddCommand(CmdAssemble())
+	cmd.AddCommand(CmdDid())
+	cmd.AddCommand(CmdGrantDidOperation())
+	cmd.AddCommand(CmdGrantIdentityFees())
+	cmd.AddCommand(CmdSendResolveDid())
 
 	return cmd
 }
@@ -56,21 +91,21 @@ func GetPayloadAndSignInputs(clientCtx client.Context, args []string) (string, [
 			inBuf := bufio.NewReader(clientCtx.Input)
 
 			var err error
-			privKey, err = input.GetString("Enter base64 encoded verification key", inBuf)
+			privKey, err = input.GetString("Enter private key (base64 encoded ed25519 key, PKCS#8, JWK or @file)", inBuf)
 
 			if err != nil {
 				return "", nil, err
 			}
 		}
 
-		privKeyBytes, err := base64.StdEncoding.DecodeString(privKey)
+		signer, err := ParsePrivateKey(privKey)
 		if err != nil {
-			return "", nil, fmt.Errorf("unable to decode private key: %s", err.Error())
+			return "", nil, err
 		}
 
 		signInput := SignInput{
 			verificationMethodId: vmId,
-			privKey:              privKeyBytes,
+			signer:               signer,
 		}
 
 		signInputs = append(signInputs, signInput)
@@ -79,11 +114,149 @@ func GetPayloadAndSignInputs(clientCtx client.Context, args []string) (string, [
 	return payloadJson, signInputs, nil
 }
 
-func SignWithSignInputs(signBytes []byte, signInputs []SignInput) []*types.SignInfo {
+// AddSignWithFlag adds the flag that references identity keys stored in the keyring
+func AddSignWithFlag(cmd *cobra.Command) {
+	cmd.Flags().StringArray(FlagSignWith, nil, "Sign with a key from the keyring: [ver-method-id]=[key-name]. Can be repeated")
+}
+
+// GetKeyringSignInputs builds sign inputs from --sign-with flags
+func GetKeyringSignInputs(clientCtx client.Context, cmd *cobra.Command) ([]SignInput, error) {
+	signWith, err := cmd.Flags().GetStringArray(FlagSignWith)
+	if err != nil {
+		return nil, err
+	}
+
+	var signInputs []SignInput
+
+	for _, value := range signWith {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid --%s value: %s. must be [ver-method-id]=[key-name]", FlagSignWith, value)
+		}
+
+		vmId, keyName := parts[0], parts[1]
+
+		if clientCtx.Keyring == nil {
+			return nil, fmt.Errorf("keyring is not available")
+		}
+
+		signInputs = append(signInputs, SignInput{
+			verificationMethodId: vmId,
+			signer: KeyringSigner{
+				keyring: clientCtx.Keyring,
+				keyName: keyName,
+			},
+		})
+	}
+
+	return signInputs, nil
+}
+
+// AddSignModeFlag adds the flag that selects the representation of the payload that is signed
+func AddSignModeFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPayloadSignMode, SignModeBinary, fmt.Sprintf("Sign the binary encoded payload (%s) or its canonical JSON form (%s)", SignModeBinary, SignModeJCS))
+}
+
+func AddSignerFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagSigner, "", "Account the operation is submitted on behalf of. Required to execute the message with authz")
+}
+
+// GetSigner reads --signer flag
+func GetSigner(cmd *cobra.Command) (string, error) {
+	signer, err := cmd.Flags().GetString(FlagSigner)
+	if err != nil {
+		return "", err
+	}
+
+	if signer == "" {
+		return "", nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return "", fmt.Errorf("invalid --%s value: %s", FlagSigner, err.Error())
+	}
+
+	return signer, nil
+}
+
+// AddReplayProtectionFlags adds flags that bind the payload to a chain and limit its lifetime
+func AddReplayProtectionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPayloadChainId, "", "Chain id the payload is accepted by. Overrides chain_id of the payload")
+	cmd.Flags().Uint64(FlagPayloadExpiryHeight, 0, "Block height after which the payload is rejected. Overrides expiry_height of the payload")
+	cmd.Flags().Uint64(FlagPayloadExpiryTime, 0, "Block time (unix seconds) after which the payload is rejected. Overrides expiry_time of the payload")
+}
+
+// SetReplayProtection sets chain id and expiry of the payload from the flags added by AddReplayProtectionFlags.
+// Values of the payload are kept for flags that aren't set.
+func SetReplayProtection(cmd *cobra.Command, payload IdentityPayload) error {
+	chainId, err := cmd.Flags().GetString(FlagPayloadChainId)
+	if err != nil {
+		return err
+	}
+
+	expiryHeight, err := cmd.Flags().GetUint64(FlagPayloadExpiryHeight)
+	if err != nil {
+		return err
+	}
+
+	expiryTime, err := cmd.Flags().GetUint64(FlagPayloadExpiryTime)
+	if err != nil {
+		return err
+	}
+
+	switch payload := payload.(type) {
+	case *types.MsgCreateDidPayload:
+		if chainId != "" {
+			payload.ChainId = chainId
+		}
+		if expiryHeight != 0 {
+			payload.ExpiryHeight = expiryHeight
+		}
+		if expiryTime != 0 {
+			payload.ExpiryTime = expiryTime
+		}
+	case *types.MsgUpdateDidPayload:
+		if chainId != "" {
+			payload.ChainId = chainId
+		}
+		if expiryHeight != 0 {
+			payload.ExpiryHeight = expiryHeight
+		}
+		if expiryTime != 0 {
+			payload.ExpiryTime = expiryTime
+		}
+	default:
+		return fmt.Errorf("unsupported payload: %T", payload)
+	}
+
+	return nil
+}
+
+// GetSignMode reads --payload-sign-mode flag
+func GetSignMode(cmd *cobra.Command) (types.SignMode, error) {
+	signMode, err := cmd.Flags().GetString(FlagPayloadSignMode)
+	if err != nil {
+		return 0, err
+	}
+
+	switch signMode {
+	case SignModeBinary:
+		return types.SignMode_SIGN_MODE_BINARY, nil
+	case SignModeJCS:
+		return types.SignMode_SIGN_MODE_JCS, nil
+	default:
+		return 0, fmt.Errorf("invalid --%s value: %s. must be one of: %s, %s", FlagPayloadSignMode, signMode, SignModeBinary, SignModeJCS)
+	}
+}
+
+func SignWithSignInputs(signBytes []byte, signInputs []SignInput) ([]*types.SignInfo, error) {
 	var signatures []*types.SignInfo
 
 	for _, signInput := range signInputs {
-		signatureBytes := ed25519.Sign(signInput.privKey, signBytes)
+		signatureBytes, err := signInput.signer.Sign(signBytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign with %s: %s", signInput.verificationMethodId, err.Error())
+		}
 
 		signInfo := types.SignInfo{
 			VerificationMethodId: signInput.verificationMethodId,
@@ -93,7 +266,50 @@ func SignWithSignInputs(signBytes []byte, signInputs []SignInput) []*types.SignI
 		signatures = append(signatures, &signInfo)
 	}
 
-	return signatures
+	return signatures, nil
+}
+
+// UnmarshalPayload decodes JSON encoded payload of the given type ('create' or 'update')
+func UnmarshalPayload(cdc codec.JSONCodec, payloadType string, payloadJson string) (IdentityPayload, error) {
+	var payload IdentityPayload
+
+	switch payloadType {
+	case PayloadTypeCreateDid:
+		payload = &types.MsgCreateDidPayload{}
+	case PayloadTypeUpdateDid:
+		payload = &types.MsgUpdateDidPayload{}
+	default:
+		return nil, fmt.Errorf("unknown payload type: %s. must be one of: %s, %s", payloadType, PayloadTypeCreateDid, PayloadTypeUpdateDid)
+	}
+
+	err := cdc.UnmarshalJSON([]byte(payloadJson), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// BuildIdentityMsg wraps the payload and its signatures into the corresponding identity message
+func BuildIdentityMsg(payload IdentityPayload, signatures []*types.SignInfo, signMode types.SignMode, signer string) (sdk.Msg, error) {
+	switch payload := payload.(type) {
+	case *types.MsgCreateDidPayload:
+		return &types.MsgCreateDid{
+			Payload:    payload,
+			Signatures: signatures,
+			SignMode:   signMode,
+			Signer:     signer,
+		}, nil
+	case *types.MsgUpdateDidPayload:
+		return &types.MsgUpdateDid{
+			Payload:    payload,
+			Signatures: signatures,
+			SignMode:   signMode,
+			Signer:     signer,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported payload: %T", payload)
+	}
 }
 
 func SetFeePayerFromSigner(ctx *client.Context) error {
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdAssemble() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assemble [create|update] [payload-json] [signature-file-1] [signature-file-N] ...",
+		Short: "Assembles an identity message from a payload and detached signatures.",
+		Long: "Builds MsgCreateDid or MsgUpdateDid from a payload and signatures produced by the 'sign-payload' command. " +
+			"[payload-json] is JSON encoded MsgCreateDidPayload or MsgUpdateDidPayload depending on the first argument. " +
+			"It must be exactly the payload that was signed. " +
+			"[signature-file-N] is a path to a file with JSON encoded SignInfo. " +
+			fmt.Sprintf("Signatures made with --%s %s must be assembled with the same flag. ", FlagPayloadSignMode, SignModeJCS) +
+			"Chain id and expiry flags passed to the 'sign-payload' command must be passed here too, " +
+			"as they change the signed payload. " +
+			"Use --generate-only flag to get an unsigned transaction or broadcast it right away.",
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := UnmarshalPayload(clientCtx.Codec, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			err = SetReplayProtection(cmd, payload)
+			if err != nil {
+				return err
+			}
+
+			signatures, err := ReadSignatures(clientCtx, args[2:])
+			if err != nil {
+				return err
+			}
+
+			signMode, err := GetSignMode(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer, err := GetSigner(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := BuildIdentityMsg(payload, signatures, signMode, signer)
+			if err != nil {
+				return err
+			}
+
+			// Set fee-payer if not set
+			err = SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddSignModeFlag(cmd)
+	AddSignerFlag(cmd)
+	AddReplayProtectionFlags(cmd)
+
+	return cmd
+}
+
+func ReadSignatures(clientCtx client.Context, files []string) ([]*types.SignInfo, error) {
+	var signatures []*types.SignInfo
+
+	for _, file := range files {
+		bytes, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var signature types.SignInfo
+		err = clientCtx.Codec.UnmarshalJSON(bytes, &signature)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode signature from %s: %s", file, err.Error())
+		}
+
+		signatures = append(signatures, &signature)
+	}
+
+	return signatures, nil
+}
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func NewTestCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func TestUnmarshalPayload(t *testing.T) {
+	cases := []struct {
+		name        string
+		payloadType string
+		payloadJson string
+		expected    IdentityPayload
+		errorMsg    string
+	}{
+		{
+			name:        "Valid: create payload",
+			payloadType: PayloadTypeCreateDid,
+			payloadJson: `{"id": "did:cheqd:test:aaaaaaaaaaaaaaaa", "controller": ["did:cheqd:test:aaaaaaaaaaaaaaaa"]}`,
+			expected: &types.MsgCreateDidPayload{
+				Id:         "did:cheqd:test:aaaaaaaaaaaaaaaa",
+				Controller: []string{"did:cheqd:test:aaaaaaaaaaaaaaaa"},
+			},
+		},
+		{
+			name:        "Valid: update payload",
+			payloadType: PayloadTypeUpdateDid,
+			payloadJson: `{"id": "did:cheqd:test:aaaaaaaaaaaaaaaa", "version_id": "version-1"}`,
+			expected: &types.MsgUpdateDidPayload{
+				Id:        "did:cheqd:test:aaaaaaaaaaaaaaaa",
+				VersionId: "version-1",
+			},
+		},
+		{
+			name:        "Not valid: unknown payload type",
+			payloadType: "deactivate",
+			payloadJson: `{"id": "did:cheqd:test:aaaaaaaaaaaaaaaa"}`,
+			errorMsg:    "unknown payload type: deactivate. must be one of: create, update",
+		},
+		{
+			name:        "Not valid: unknown field",
+			payloadType: PayloadTypeCreateDid,
+			payloadJson: `{"id": "did:cheqd:test:aaaaaaaaaaaaaaaa", "version_id": "version-1"}`,
+			errorMsg:    "unknown field \"version_id\"",
+		},
+		{
+			name:        "Not valid: not a JSON",
+			payloadType: PayloadTypeUpdateDid,
+			payloadJson: `did:cheqd:test:aaaaaaaaaaaaaaaa`,
+			errorMsg:    "invalid character",
+		},
+	}
+
+	cdc := NewTestCodec()
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := UnmarshalPayload(cdc, tc.payloadType, tc.payloadJson)
+
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, payload)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
+
+type unsupportedPayload struct {
+	*types.MsgCreateDidPayload
+}
+
+func TestBuildIdentityMsg(t *testing.T) {
+	signatures := []*types.SignInfo{{VerificationMethodId: "did:cheqd:test:aaaaaaaaaaaaaaaa#key-1", Signature: "c2lnbmF0dXJl"}}
+	createPayload := &types.MsgCreateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa"}
+	updatePayload := &types.MsgUpdateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa", VersionId: "version-1"}
+
+	cases := []struct {
+		name     string
+		payload  IdentityPayload
+		signMode types.SignMode
+		signer   string
+		expected interface{}
+		errorMsg string
+	}{
+		{
+			name:     "Valid: create",
+			payload:  createPayload,
+			signMode: types.SignMode_SIGN_MODE_BINARY,
+			expected: &types.MsgCreateDid{Payload: createPayload, Signatures: signatures},
+		},
+		{
+			name:     "Valid: update with JCS sign mode and signer",
+			payload:  updatePayload,
+			signMode: types.SignMode_SIGN_MODE_JCS,
+			signer:   "cheqd1signer",
+			expected: &types.MsgUpdateDid{Payload: updatePayload, Signatures: signatures, SignMode: types.SignMode_SIGN_MODE_JCS, Signer: "cheqd1signer"},
+		},
+		{
+			name:     "Not valid: unsupported payload",
+			payload:  unsupportedPayload{createPayload},
+			errorMsg: "unsupported payload: cli.unsupportedPayload",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := BuildIdentityMsg(tc.payload, signatures, tc.signMode, tc.signer)
+
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, msg)
+			} else {
+				require.EqualError(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestReadSignatures(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	first := writeFile("first.json", `{"verification_method_id": "did:cheqd:test:aaaaaaaaaaaaaaaa#key-1", "signature": "Zmlyc3Q="}`)
+	second := writeFile("second.json", `{"verification_method_id": "did:cheqd:test:bbbbbbbbbbbbbbbb#key-1", "signature": "c2Vjb25k"}`)
+	invalid := writeFile("invalid.json", `{"verification_method_id": 1}`)
+	missing := filepath.Join(dir, "missing.json")
+
+	cases := []struct {
+		name     string
+		files    []string
+		expected []*types.SignInfo
+		errorMsg string
+	}{
+		{
+			name:  "Valid: signatures keep the order of files",
+			files: []string{second, first},
+			expected: []*types.SignInfo{
+				{VerificationMethodId: "did:cheqd:test:bbbbbbbbbbbbbbbb#key-1", Signature: "c2Vjb25k"},
+				{VerificationMethodId: "did:cheqd:test:aaaaaaaaaaaaaaaa#key-1", Signature: "Zmlyc3Q="},
+			},
+		},
+		{
+			name:  "Valid: no files",
+			files: nil,
+		},
+		{
+			name:     "Not valid: invalid JSON",
+			files:    []string{first, invalid},
+			errorMsg: "unable to decode signature from " + invalid,
+		},
+		{
+			name:     "Not valid: missing file",
+			files:    []string{missing},
+			errorMsg: "no such file or directory",
+		},
+	}
+
+	clientCtx := client.Context{}.WithCodec(NewTestCodec())
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			signatures, err := ReadSignatures(clientCtx, tc.files)
+
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, signatures)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
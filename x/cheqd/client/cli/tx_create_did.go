@@ -15,9 +15,13 @@ func CmdCreateDid() *cobra.Command {
 		Long: "Creates a new DID. " +
 			"[payload-json] is JSON encoded MsgCreateDidPayload. " +
 			"[ver-method-id-N] is the DID fragment that points to the public part of the key in the ledger for the signature N." +
-			"[priv-key-1] is base base64 encoded ed25519 private key for signature N." +
+			"[priv-key-N] is the private key for signature N: base64 encoded ed25519 key, PEM or base64 encoded PKCS#8, " +
+			"PEM encoded PKCS#1 (RSA) or SEC 1 (EC) key, or JWK. Use '@path' to read the key from a file. " +
 			"If 'interactive' value is used for a key, the key will be read interactively. " +
-			"Prefer interactive mode, use inline mode only for tests.",
+			"Prefer interactive mode, use inline mode only for tests. " +
+			"Keys stored in the keyring can be used instead of raw keys with --sign-with [ver-method-id]=[key-name] flag. " +
+			"With --payload-sign-mode jcs the canonical JSON form of the payload is signed instead of its binary encoding. " +
+			"The payload can be bound to a chain and expire with --payload-chain-id, --payload-expiry-height and --payload-expiry-time flags.",
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -30,6 +34,13 @@ func CmdCreateDid() *cobra.Command {
 				return err
 			}
 
+			keyringSignInputs, err := GetKeyringSignInputs(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+
+			signInputs = append(signInputs, keyringSignInputs...)
+
 			// Unmarshal payload
 			var payload types.MsgCreateDidPayload
 			err = clientCtx.Codec.UnmarshalJSON([]byte(payloadJson), &payload)
@@ -37,13 +48,37 @@ func CmdCreateDid() *cobra.Command {
 				return err
 			}
 
+			err = SetReplayProtection(cmd, &payload)
+			if err != nil {
+				return err
+			}
+
 			// Build identity message
-			signBytes := payload.GetSignBytes()
-			identitySignatures := SignWithSignInputs(signBytes, signInputs)
+			signMode, err := GetSignMode(cmd)
+			if err != nil {
+				return err
+			}
+
+			signBytes, err := payload.GetSignBytesForMode(signMode)
+			if err != nil {
+				return err
+			}
+
+			identitySignatures, err := SignWithSignInputs(signBytes, signInputs)
+			if err != nil {
+				return err
+			}
+
+			signer, err := GetSigner(cmd)
+			if err != nil {
+				return err
+			}
 
 			msg := types.MsgCreateDid{
 				Payload:    &payload,
 				Signatures: identitySignatures,
+				SignMode:   signMode,
+				Signer:     signer,
 			}
 
 			// Set fee-payer if not set
@@ -57,6 +92,10 @@ func CmdCreateDid() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddSignWithFlag(cmd)
+	AddSignModeFlag(cmd)
+	AddSignerFlag(cmd)
+	AddReplayProtectionFlags(cmd)
 
 	return cmd
 }
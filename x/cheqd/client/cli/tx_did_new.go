@@ -0,0 +1,264 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	cheqdkeys "github.com/cheqd/cheqd-node/x/cheqd/client/keys"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagNamespace    = "namespace"
+	FlagIdLength     = "id-length"
+	FlagIdFormat     = "id-format"
+	FlagKey          = "key"
+	FlagController   = "controller"
+	FlagService      = "service"
+	FlagBoundAccount = "bound-account"
+	FlagBroadcast    = "broadcast"
+)
+
+// CmdDid returns the group of DID document helper commands
+func CmdDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "did",
+		Short:                      "DID document helpers",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdDidNew())
+
+	return cmd
+}
+
+func CmdDidNew() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "new",
+		Short: "Builds a new DID document.",
+		Long: "Builds MsgCreateDidPayload for a new DID with a random unique id in the given namespace. " +
+			fmt.Sprintf("With --%s %s the unique id is derived from the first key instead. ", FlagIdFormat, types.UniqueIdFormatSelfCertifying) +
+			fmt.Sprintf("A verification method is added for each --%s, which is a name of a key in the keyring. ", FlagKey) +
+			"Verification methods are referenced in authentication, assertion method and capability invocation relationships. " +
+			fmt.Sprintf("Services are added with --%s [fragment],[type],[endpoint]. ", FlagService) +
+			fmt.Sprintf("With --%s the DID is bound to the account, which then must be the --%s of its operations. ", FlagBoundAccount, FlagSigner) +
+			fmt.Sprintf("The payload is printed, with --%s flag it's signed with the keys and broadcasted instead.", FlagBroadcast),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := cmd.Flags().GetString(FlagNamespace)
+			if err != nil {
+				return err
+			}
+
+			idLength, err := cmd.Flags().GetInt(FlagIdLength)
+			if err != nil {
+				return err
+			}
+
+			idFormat, err := cmd.Flags().GetString(FlagIdFormat)
+			if err != nil {
+				return err
+			}
+
+			keyNames, err := cmd.Flags().GetStringArray(FlagKey)
+			if err != nil {
+				return err
+			}
+
+			controllers, err := cmd.Flags().GetStringArray(FlagController)
+			if err != nil {
+				return err
+			}
+
+			services, err := cmd.Flags().GetStringArray(FlagService)
+			if err != nil {
+				return err
+			}
+
+			boundAccount, err := cmd.Flags().GetString(FlagBoundAccount)
+			if err != nil {
+				return err
+			}
+
+			broadcast, err := cmd.Flags().GetBool(FlagBroadcast)
+			if err != nil {
+				return err
+			}
+
+			signer, err := GetSigner(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Operations on a bound DID must be submitted by the bound account
+			if signer == "" {
+				signer = boundAccount
+			}
+
+			payload, signInputs, err := BuildDidPayload(clientCtx, namespace, idFormat, idLength, keyNames, controllers, services, boundAccount)
+			if err != nil {
+				return err
+			}
+
+			err = SetReplayProtection(cmd, payload)
+			if err != nil {
+				return err
+			}
+
+			if !broadcast {
+				return clientCtx.PrintProto(payload)
+			}
+
+			signMode, err := GetSignMode(cmd)
+			if err != nil {
+				return err
+			}
+
+			signBytes, err := payload.GetSignBytesForMode(signMode)
+			if err != nil {
+				return err
+			}
+
+			signatures, err := SignWithSignInputs(signBytes, signInputs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateDid{
+				Payload:    payload,
+				Signatures: signatures,
+				SignMode:   signMode,
+				Signer:     signer,
+			}
+
+			// Set fee-payer if not set
+			err = SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagNamespace, "", "Namespace of the DID, e.g. mainnet or testnet")
+	cmd.Flags().String(FlagIdFormat, types.UniqueIdFormatBase58, "Format of the unique id: base58, uuid or self-certifying")
+	cmd.Flags().Int(FlagIdLength, 16, "Length of the base58 unique id: 16 or 32")
+	cmd.Flags().StringArray(FlagKey, nil, "Name of a key in the keyring to add as a verification method. Can be repeated")
+	cmd.Flags().StringArray(FlagController, nil, "Controller DID. Can be repeated")
+	cmd.Flags().StringArray(FlagService, nil, "Service: [fragment],[type],[endpoint]. Can be repeated")
+	cmd.Flags().String(FlagBoundAccount, "", "Account the DID is bound to. Defaults --signer to this account")
+	cmd.Flags().Bool(FlagBroadcast, false, "Sign the payload with the keys and broadcast it")
+	_ = cmd.MarkFlagRequired(FlagNamespace)
+	flags.AddTxFlagsToCmd(cmd)
+	AddSignModeFlag(cmd)
+	AddSignerFlag(cmd)
+	AddReplayProtectionFlags(cmd)
+
+	return cmd
+}
+
+// BuildDidPayload builds the payload of a new DID and sign inputs for keys of its verification methods
+func BuildDidPayload(clientCtx client.Context, namespace string, idFormat string, idLength int, keyNames []string,
+	controllers []string, services []string, boundAccount string,
+) (*types.MsgCreateDidPayload, []SignInput, error) {
+	uniqueId, err := BuildUniqueId(clientCtx, idFormat, idLength, keyNames)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	did := utils.JoinDID(types.DidMethod, namespace, uniqueId)
+	payload := types.MsgCreateDidPayload{
+		Id:           did,
+		Controller:   controllers,
+		BoundAccount: boundAccount,
+	}
+
+	var signInputs []SignInput
+
+	for i, keyName := range keyNames {
+		info, err := clientCtx.Keyring.Key(keyName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		vmId := fmt.Sprintf("%s#key-%d", did, i+1)
+		vm, err := cheqdkeys.NewVerificationMethod(vmId, did, info.GetPubKey())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		payload.VerificationMethod = append(payload.VerificationMethod, vm)
+		payload.Authentication = append(payload.Authentication, types.NewVerificationMethodReference(vmId))
+		payload.AssertionMethod = append(payload.AssertionMethod, types.NewVerificationMethodReference(vmId))
+		payload.CapabilityInvocation = append(payload.CapabilityInvocation, types.NewVerificationMethodReference(vmId))
+
+		signInputs = append(signInputs, SignInput{
+			verificationMethodId: vmId,
+			signer: KeyringSigner{
+				keyring: clientCtx.Keyring,
+				keyName: keyName,
+			},
+		})
+	}
+
+	for _, service := range services {
+		parts := strings.SplitN(service, ",", 3)
+		if len(parts) != 3 {
+			return nil, nil, fmt.Errorf("invalid --%s value: %s. must be [fragment],[type],[endpoint]", FlagService, service)
+		}
+
+		payload.Service = append(payload.Service, &types.Service{
+			Id:              did + "#" + parts[0],
+			Type:            parts[1],
+			ServiceEndpoint: parts[2],
+		})
+	}
+
+	err = payload.Validate([]string{namespace})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &payload, signInputs, nil
+}
+
+// BuildUniqueId generates a unique id in the given format. Self-certifying ids are derived from the first key.
+func BuildUniqueId(clientCtx client.Context, idFormat string, idLength int, keyNames []string) (string, error) {
+	switch idFormat {
+	case types.UniqueIdFormatBase58:
+		return utils.GenerateUniqueId(idLength)
+	case types.UniqueIdFormatUUID:
+		return utils.GenerateUUID()
+	case types.UniqueIdFormatSelfCertifying:
+		if len(keyNames) == 0 {
+			return "", fmt.Errorf("self-certifying unique id requires at least one --%s", FlagKey)
+		}
+
+		info, err := clientCtx.Keyring.Key(keyNames[0])
+		if err != nil {
+			return "", err
+		}
+
+		vm, err := cheqdkeys.NewVerificationMethod("", "", info.GetPubKey())
+		if err != nil {
+			return "", err
+		}
+
+		return vm.SelfCertifyingUniqueId()
+	default:
+		return "", fmt.Errorf("invalid --%s value: %s. must be one of: %s", FlagIdFormat, idFormat, strings.Join(types.SupportedUniqueIdFormats, ", "))
+	}
+}
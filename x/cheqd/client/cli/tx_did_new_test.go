@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	cheqdkeys "github.com/cheqd/cheqd-node/x/cheqd/client/keys"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDidPayload(t *testing.T) {
+	kr, err := keyring.New("cheqd", keyring.BackendMemory, t.TempDir(), nil, cheqdkeys.KeyringOption())
+	require.NoError(t, err)
+
+	_, _, err = kr.NewMnemonic("ed25519", keyring.English, "", "", cheqdkeys.Ed25519)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("p256", keyring.English, "", "", cheqdkeys.P256)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyring(kr)
+
+	cases := []struct {
+		name        string
+		idFormat    string
+		idLength    int
+		keyNames    []string
+		controllers []string
+		services    []string
+		account     string
+		check       func(t *testing.T, payload *types.MsgCreateDidPayload)
+		errorMsg    string
+	}{
+		{
+			name:     "Valid: base58 id and two keys",
+			idFormat: types.UniqueIdFormatBase58,
+			idLength: 16,
+			keyNames: []string{"ed25519", "p256"},
+			services: []string{"linked-domain,LinkedDomains,https://example.com"},
+			check: func(t *testing.T, payload *types.MsgCreateDidPayload) {
+				_, namespace, id, err := utils.TrySplitDID(payload.Id)
+				require.NoError(t, err)
+				require.Equal(t, "testnet", namespace)
+				require.Len(t, id, 16)
+
+				require.Len(t, payload.VerificationMethod, 2)
+				require.Equal(t, payload.Id+"#key-1", payload.VerificationMethod[0].Id)
+				require.Equal(t, types.Ed25519VerificationKey2020, payload.VerificationMethod[0].Type)
+				require.Equal(t, types.JsonWebKey2020, payload.VerificationMethod[1].Type)
+				require.Equal(t, types.VerificationMethodReferences(payload.Id+"#key-1", payload.Id+"#key-2"), payload.Authentication)
+				require.Equal(t, payload.Authentication, payload.AssertionMethod)
+				require.Equal(t, payload.Authentication, payload.CapabilityInvocation)
+				require.Empty(t, payload.BoundAccount)
+
+				require.Equal(t, []*types.Service{{Id: payload.Id + "#linked-domain", Type: "LinkedDomains", ServiceEndpoint: "https://example.com"}}, payload.Service)
+			},
+		},
+		{
+			name:        "Valid: uuid id with a controller",
+			idFormat:    types.UniqueIdFormatUUID,
+			controllers: []string{"did:cheqd:testnet:aaaaaaaaaaaaaaaa"},
+			check: func(t *testing.T, payload *types.MsgCreateDidPayload) {
+				_, _, id, err := utils.TrySplitDID(payload.Id)
+				require.NoError(t, err)
+				require.True(t, utils.IsUUID(id))
+				require.Equal(t, []string{"did:cheqd:testnet:aaaaaaaaaaaaaaaa"}, payload.Controller)
+				require.Empty(t, payload.VerificationMethod)
+			},
+		},
+		{
+			name:     "Valid: self-certifying id is derived from the first key",
+			idFormat: types.UniqueIdFormatSelfCertifying,
+			keyNames: []string{"ed25519"},
+			check: func(t *testing.T, payload *types.MsgCreateDidPayload) {
+				expected, err := payload.VerificationMethod[0].SelfCertifyingUniqueId()
+				require.NoError(t, err)
+				require.True(t, strings.HasSuffix(payload.Id, ":"+expected))
+			},
+		},
+		{
+			name:     "Valid: bound to an account",
+			idFormat: types.UniqueIdFormatUUID,
+			keyNames: []string{"ed25519"},
+			account:  sdk.AccAddress("bound_account_______").String(),
+			check: func(t *testing.T, payload *types.MsgCreateDidPayload) {
+				require.Equal(t, sdk.AccAddress("bound_account_______").String(), payload.BoundAccount)
+			},
+		},
+		{
+			name:     "Not valid: bound account isn't an account address",
+			idFormat: types.UniqueIdFormatUUID,
+			account:  "did:cheqd:testnet:aaaaaaaaaaaaaaaa",
+			errorMsg: "bound_account:",
+		},
+		{
+			name:     "Not valid: self-certifying id without keys",
+			idFormat: types.UniqueIdFormatSelfCertifying,
+			errorMsg: "self-certifying unique id requires at least one --key",
+		},
+		{
+			name:     "Not valid: unknown id format",
+			idFormat: "sequence",
+			errorMsg: "invalid --id-format value: sequence. must be one of: base58, uuid, self-certifying",
+		},
+		{
+			name:     "Not valid: unknown key",
+			idFormat: types.UniqueIdFormatBase58,
+			idLength: 16,
+			keyNames: []string{"missing"},
+			errorMsg: "missing.info: key not found",
+		},
+		{
+			name:     "Not valid: malformed service",
+			idFormat: types.UniqueIdFormatBase58,
+			idLength: 16,
+			keyNames: []string{"ed25519"},
+			services: []string{"linked-domain,LinkedDomains"},
+			errorMsg: "invalid --service value: linked-domain,LinkedDomains. must be [fragment],[type],[endpoint]",
+		},
+		{
+			name:        "Not valid: payload doesn't pass validation",
+			idFormat:    types.UniqueIdFormatBase58,
+			idLength:    16,
+			controllers: []string{"not a did"},
+			errorMsg:    "controller:",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, signInputs, err := BuildDidPayload(clientCtx, "testnet", tc.idFormat, tc.idLength, tc.keyNames, tc.controllers, tc.services, tc.account)
+
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				require.Len(t, signInputs, len(tc.keyNames))
+				tc.check(t, payload)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
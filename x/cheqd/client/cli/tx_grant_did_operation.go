@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDids       = "dids"
+	FlagExpiration = "expiration"
+)
+
+func CmdGrantDidOperation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-did-operation [grantee] [create|update]",
+		Short: "Allows another account to submit identity operations on behalf of the granter.",
+		Long: "Grants DidOperationAuthorization to [grantee]. The grantee can then execute MsgCreateDid or MsgUpdateDid " +
+			"with --signer set to the granter address using 'tx authz exec'. DID signatures are still required. " +
+			fmt.Sprintf("Use --%s to limit the grant to specific DIDs, any DID is allowed otherwise. ", FlagDids) +
+			fmt.Sprintf("--%s is a unix timestamp, the grant expires in a year by default.", FlagExpiration),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgTypeURL, err := OperationMsgTypeURL(args[1])
+			if err != nil {
+				return err
+			}
+
+			dids, err := cmd.Flags().GetStringSlice(FlagDids)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewDidOperationAuthorization(msgTypeURL, dids...)
+			err = authorization.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(expiration, 0))
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagDids, nil, "DIDs the grantee is allowed to operate on. Comma separated")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "Expiration time of the grant as a unix timestamp")
+
+	return cmd
+}
+
+// OperationMsgTypeURL maps an operation name to the type URL of the identity message
+func OperationMsgTypeURL(operation string) (string, error) {
+	switch operation {
+	case PayloadTypeCreateDid:
+		return sdk.MsgTypeURL(&types.MsgCreateDid{}), nil
+	case PayloadTypeUpdateDid:
+		return sdk.MsgTypeURL(&types.MsgUpdateDid{}), nil
+	default:
+		return "", fmt.Errorf("invalid operation: %s. must be one of: %s, %s", operation, PayloadTypeCreateDid, PayloadTypeUpdateDid)
+	}
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagSpendLimit          = "spend-limit"
+	FlagNamespaces          = "namespaces"
+	FlagMaxOperationsPerDid = "max-operations-per-did"
+)
+
+func CmdGrantIdentityFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-identity-fees [grantee]",
+		Short: "Allows another account to pay fees of identity transactions from the granter's account.",
+		Long: "Grants IdentityFeeAllowance to [grantee]. The grantee can use it with --fee-account flag " +
+			"for transactions that consist of MsgCreateDid and MsgUpdateDid only. " +
+			fmt.Sprintf("Use --%s and --%s to limit the allowance to specific DIDs or namespaces, ", FlagDids, FlagNamespaces) +
+			fmt.Sprintf("--%s to limit the number of operations paid for each DID, ", FlagMaxOperationsPerDid) +
+			fmt.Sprintf("--%s to limit the total amount of fees. ", FlagSpendLimit) +
+			fmt.Sprintf("--%s is a unix timestamp, the allowance doesn't expire if it's not set.", FlagExpiration),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := GetIdentityFeeAllowance(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = allowance.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg, err := feegrant.NewMsgGrantAllowance(allowance, clientCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpendLimit, "", "Maximum amount of fees the grantee can spend, e.g. 1000000000ncheq. Unlimited if not set")
+	cmd.Flags().Int64(FlagExpiration, 0, "Expiration time of the allowance as a unix timestamp")
+	cmd.Flags().StringSlice(FlagDids, nil, "DIDs fees are paid for. Comma separated")
+	cmd.Flags().StringSlice(FlagNamespaces, nil, "Namespaces of DIDs fees are paid for. Comma separated")
+	cmd.Flags().Uint64(FlagMaxOperationsPerDid, 0, fmt.Sprintf("Maximum number of operations paid for each DID. Unlimited if 0. Operations are counted for up to %d DIDs", types.MaxTrackedDids))
+
+	return cmd
+}
+
+// GetIdentityFeeAllowance builds IdentityFeeAllowance from the flags of grant-identity-fees command
+func GetIdentityFeeAllowance(cmd *cobra.Command) (*types.IdentityFeeAllowance, error) {
+	spendLimit, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration, err := cmd.Flags().GetInt64(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	dids, err := cmd.Flags().GetStringSlice(FlagDids)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := cmd.Flags().GetStringSlice(FlagNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	maxOperationsPerDid, err := cmd.Flags().GetUint64(FlagMaxOperationsPerDid)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := types.IdentityFeeAllowance{
+		Dids:                dids,
+		Namespaces:          namespaces,
+		MaxOperationsPerDid: maxOperationsPerDid,
+	}
+
+	if spendLimit != "" {
+		allowance.SpendLimit, err = sdk.ParseCoinsNormalized(spendLimit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s value: %s", FlagSpendLimit, err.Error())
+		}
+	}
+
+	if expiration != 0 {
+		expiresAt := time.Unix(expiration, 0)
+		allowance.Expiration = &expiresAt
+	}
+
+	return &allowance, nil
+}
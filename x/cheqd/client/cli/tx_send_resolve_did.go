@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagPacketTimeoutHeight    = "packet-timeout-height"
+	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+)
+
+func CmdSendResolveDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-resolve-did [src-port] [src-channel] [did]",
+		Short: "Requests a DID Doc from the chain connected through the channel.",
+		Long: "Sends an IBC packet asking the counterparty chain to resolve [did]. The DID Doc is returned in the " +
+			"packet acknowledgement once a relayer delivers the packet. " +
+			fmt.Sprintf("--%s is {revision}-{height} on the counterparty chain, ", FlagPacketTimeoutHeight) +
+			fmt.Sprintf("--%s is in unix nanoseconds and defaults to 10 minutes from now. Zero values disable the timeout.", FlagPacketTimeoutTimestamp),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(FlagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(FlagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendResolveDid(clientCtx.GetFromAddress().String(), args[0], args[1], args[2], timeoutHeight, timeoutTimestamp)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagPacketTimeoutHeight, "0-0", "Timeout height on the counterparty chain: {revision}-{height}")
+	cmd.Flags().Uint64(FlagPacketTimeoutTimestamp, uint64(time.Now().Add(10*time.Minute).UnixNano()), "Timeout timestamp on the counterparty chain in unix nanoseconds")
+
+	return cmd
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const FlagSignBytes = "sign-bytes"
+
+func CmdSignPayload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-payload [create|update] [payload-json] [ver-method-id] [priv-key]",
+		Short: "Signs an identity payload offline.",
+		Long: "Produces a detached signature of an identity payload. Doesn't require connection to a node, " +
+			"so the command can be run on an air-gapped machine. " +
+			"[payload-json] is JSON encoded MsgCreateDidPayload or MsgUpdateDidPayload depending on the first argument. " +
+			"[ver-method-id] is the DID fragment that points to the public part of the key in the ledger. " +
+			"[priv-key] is base64 encoded ed25519 private key, PEM or base64 encoded PKCS#8, " +
+			"PEM encoded PKCS#1 (RSA) or SEC 1 (EC) key, or JWK. Use '@path' to read the key from a file. " +
+			"If 'interactive' value is used for a key, the key will be read interactively. " +
+			"A key stored in the keyring can be used instead with --sign-with [ver-method-id]=[key-name] flag. " +
+			"The signature is printed as JSON encoded SignInfo and can be passed to the 'assemble' command. " +
+			fmt.Sprintf("With --%s %s the canonical JSON form of the payload is signed, ", FlagPayloadSignMode, SignModeJCS) +
+			"the same sign mode must be passed to the 'assemble' command then. " +
+			"Chain id and expiry set with --payload-chain-id, --payload-expiry-height and --payload-expiry-time flags " +
+			"are part of the signed payload, so the same flags must be passed to the 'assemble' command too. " +
+			fmt.Sprintf("With --%s flag the command prints base64 encoded sign bytes instead, ", FlagSignBytes) +
+			"[ver-method-id] and [priv-key] are not required in this case.",
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := UnmarshalPayload(clientCtx.Codec, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			err = SetReplayProtection(cmd, payload)
+			if err != nil {
+				return err
+			}
+
+			signMode, err := GetSignMode(cmd)
+			if err != nil {
+				return err
+			}
+
+			signBytes, err := payload.GetSignBytesForMode(signMode)
+			if err != nil {
+				return err
+			}
+
+			signBytesOnly, err := cmd.Flags().GetBool(FlagSignBytes)
+			if err != nil {
+				return err
+			}
+
+			var output []byte
+
+			if signBytesOnly {
+				if len(args) != 2 {
+					return fmt.Errorf("keys must not be passed with --%s flag", FlagSignBytes)
+				}
+
+				output = []byte(base64.StdEncoding.EncodeToString(signBytes))
+			} else {
+				_, signInputs, err := GetPayloadAndSignInputs(clientCtx, args[1:])
+				if err != nil {
+					return err
+				}
+
+				keyringSignInputs, err := GetKeyringSignInputs(clientCtx, cmd)
+				if err != nil {
+					return err
+				}
+
+				signInputs = append(signInputs, keyringSignInputs...)
+				if len(signInputs) != 1 {
+					return fmt.Errorf("exactly one key must be passed, got: %d", len(signInputs))
+				}
+
+				signatures, err := SignWithSignInputs(signBytes, signInputs)
+				if err != nil {
+					return err
+				}
+
+				output, err = clientCtx.Codec.MarshalJSON(signatures[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			return WriteOutputDocument(cmd, output)
+		},
+	}
+
+	cmd.Flags().Bool(FlagSignBytes, false, "Print base64 encoded sign bytes instead of signing them")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	AddSignWithFlag(cmd)
+	AddSignModeFlag(cmd)
+	AddReplayProtectionFlags(cmd)
+
+	return cmd
+}
+
+// WriteOutputDocument writes the output to the file passed in --output-document flag or to STDOUT
+func WriteOutputDocument(cmd *cobra.Command, output []byte) error {
+	outputDoc, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if err != nil {
+		return err
+	}
+
+	var writer io.Writer = cmd.OutOrStdout()
+
+	if outputDoc != "" {
+		file, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+		writer = file
+	}
+
+	_, err = fmt.Fprintf(writer, "%s\n", output)
+	return err
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTxCmd(t *testing.T) {
+	// Flags of identity commands must not clash with the standard tx flags
+	require.NotPanics(t, func() { GetTxCmd() })
+}
+
+func TestAssembleReplayProtectionFlags(t *testing.T) {
+	// Flags applied to the payload by sign-payload must be applied by assemble too, otherwise signatures don't match
+	for _, flag := range []string{FlagPayloadChainId, FlagPayloadExpiryHeight, FlagPayloadExpiryTime} {
+		require.NotNil(t, CmdSignPayload().Flags().Lookup(flag), flag)
+		require.NotNil(t, CmdAssemble().Flags().Lookup(flag), flag)
+	}
+}
+
+func TestSetReplayProtection(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		payload  IdentityPayload
+		expected IdentityPayload
+		errorMsg string
+	}{
+		{
+			name:     "Valid: flags aren't set",
+			payload:  &types.MsgCreateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa", ChainId: "test", ExpiryHeight: 10},
+			expected: &types.MsgCreateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa", ChainId: "test", ExpiryHeight: 10},
+		},
+		{
+			name:     "Valid: create payload",
+			args:     []string{"--payload-chain-id", "cheqd-mainnet-1", "--payload-expiry-height", "100", "--payload-expiry-time", "1609459200"},
+			payload:  &types.MsgCreateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa"},
+			expected: &types.MsgCreateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa", ChainId: "cheqd-mainnet-1", ExpiryHeight: 100, ExpiryTime: 1609459200},
+		},
+		{
+			name:     "Valid: flags override the update payload",
+			args:     []string{"--payload-chain-id", "cheqd-mainnet-1"},
+			payload:  &types.MsgUpdateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa", ChainId: "test", ExpiryTime: 1609459200},
+			expected: &types.MsgUpdateDidPayload{Id: "did:cheqd:test:aaaaaaaaaaaaaaaa", ChainId: "cheqd-mainnet-1", ExpiryTime: 1609459200},
+		},
+		{
+			name:     "Not valid: unsupported payload",
+			payload:  unsupportedPayload{&types.MsgCreateDidPayload{}},
+			errorMsg: "unsupported payload: cli.unsupportedPayload",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			AddReplayProtectionFlags(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			err := SetReplayProtection(cmd, tc.payload)
+
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, tc.payload)
+			} else {
+				require.EqualError(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -15,9 +17,15 @@ func CmdUpdateDid() *cobra.Command {
 		Long: "Updates a DID. " +
 			"[payload-json] is JSON encoded MsgUpdateDidPayload. " +
 			"[ver-method-id-N] is the DID fragment that points to the public part of the key in the ledger for the signature N." +
-			"[priv-key-1] is base base64 encoded ed25519 private key for signature N." +
+			"[priv-key-N] is the private key for signature N: base64 encoded ed25519 key, PEM or base64 encoded PKCS#8, " +
+			"PEM encoded PKCS#1 (RSA) or SEC 1 (EC) key, or JWK. Use '@path' to read the key from a file. " +
 			"If 'interactive' value is used for a key, the key will be read interactively. " +
-			"Prefer interactive mode, use inline mode only for tests.",
+			"Prefer interactive mode, use inline mode only for tests. " +
+			"Keys stored in the keyring can be used instead of raw keys with --sign-with [ver-method-id]=[key-name] flag. " +
+			"With --payload-sign-mode jcs the canonical JSON form of the payload is signed instead of its binary encoding. " +
+			"The payload can be bound to a chain and expire with --payload-chain-id, --payload-expiry-height and --payload-expiry-time flags. " +
+			"With --dry-run flag the update is validated against the current DID Doc and the latest block first, " +
+			"and required signers with missing or invalid signatures are reported.",
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -30,6 +38,13 @@ func CmdUpdateDid() *cobra.Command {
 				return err
 			}
 
+			keyringSignInputs, err := GetKeyringSignInputs(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+
+			signInputs = append(signInputs, keyringSignInputs...)
+
 			// Unmarshal payload
 			var payload types.MsgUpdateDidPayload
 			err = clientCtx.Codec.UnmarshalJSON([]byte(payloadJson), &payload)
@@ -37,13 +52,54 @@ func CmdUpdateDid() *cobra.Command {
 				return err
 			}
 
+			err = SetReplayProtection(cmd, &payload)
+			if err != nil {
+				return err
+			}
+
 			// Build identity message
-			signBytes := payload.GetSignBytes()
-			identitySignatures := SignWithSignInputs(signBytes, signInputs)
+			signMode, err := GetSignMode(cmd)
+			if err != nil {
+				return err
+			}
+
+			signBytes, err := payload.GetSignBytesForMode(signMode)
+			if err != nil {
+				return err
+			}
+
+			identitySignatures, err := SignWithSignInputs(signBytes, signInputs)
+			if err != nil {
+				return err
+			}
+
+			signer, err := GetSigner(cmd)
+			if err != nil {
+				return err
+			}
 
 			msg := types.MsgUpdateDid{
 				Payload:    &payload,
 				Signatures: identitySignatures,
+				SignMode:   signMode,
+				Signer:     signer,
+			}
+
+			// Explain what the ledger would say before spending fees
+			if clientCtx.Simulate {
+				report, err := QueryDryRunUpdateDid(clientCtx, &msg)
+				if err != nil {
+					return err
+				}
+
+				err = PrintDryRunReport(clientCtx, report)
+				if err != nil {
+					return err
+				}
+
+				if !report.Valid {
+					return fmt.Errorf("update of %s would be rejected by the ledger", payload.Id)
+				}
 			}
 
 			// Set fee-payer if not set
@@ -57,6 +113,10 @@ func CmdUpdateDid() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	AddSignWithFlag(cmd)
+	AddSignModeFlag(cmd)
+	AddSignerFlag(cmd)
+	AddReplayProtectionFlags(cmd)
 
 	return cmd
 }
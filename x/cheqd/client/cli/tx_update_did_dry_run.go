@@ -0,0 +1,237 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	SignerStatusValid   = "valid"
+	SignerStatusMissing = "missing"
+	SignerStatusInvalid = "invalid"
+)
+
+// DidResolver returns the current version of a DID Doc
+type DidResolver func(id string) (*types.Did, error)
+
+// SignerReport explains whether the signatures of a required signer are correct
+type SignerReport struct {
+	Signer  string   `json:"signer"`
+	Status  string   `json:"status"`
+	Details []string `json:"details,omitempty"`
+}
+
+// DryRunBlock is the chain and block the update is expected to be included in
+type DryRunBlock struct {
+	ChainId string
+	Height  int64
+	Time    time.Time
+}
+
+// DryRunReport is the result of client-side validation of an update
+type DryRunReport struct {
+	Valid   bool           `json:"valid"`
+	Errors  []string       `json:"errors,omitempty"`
+	Signers []SignerReport `json:"signers,omitempty"`
+}
+
+func (r *DryRunReport) AddError(err error) {
+	r.Valid = false
+	r.Errors = append(r.Errors, err.Error())
+}
+
+// DryRunUpdateDid repeats the checks of the ledger: validation, replay protection, deactivation, bound account,
+// version id, limits and contexts, then computes the required signers the same way the ledger does and checks
+// their signatures.
+func DryRunUpdateDid(msg *types.MsgUpdateDid, existingDid types.Did, existingMetadata types.Metadata, params types.Params, block DryRunBlock, resolve DidResolver) DryRunReport {
+	report := DryRunReport{Valid: true}
+
+	_, namespace, _ := utils.MustSplitDID(existingDid.Id)
+
+	if err := msg.Validate([]string{namespace}); err != nil {
+		report.AddError(types.ErrNamespaceValidation.Wrap(err.Error()))
+		return report
+	}
+
+	if err := keeper.ValidateReplayProtectionAt(msg.Payload, block.ChainId, block.Height, block.Time); err != nil {
+		report.AddError(err)
+	}
+
+	if err := keeper.ValidateDidActive(existingDid.Id, &existingMetadata); err != nil {
+		report.AddError(err)
+	}
+
+	if err := keeper.ValidateBoundAccount(&existingDid, msg.Signer); err != nil {
+		report.AddError(err)
+	}
+
+	if msg.Payload.VersionId != existingMetadata.VersionId {
+		report.AddError(types.ErrUnexpectedDidVersion.Wrapf("got: %s, must be: %s", msg.Payload.VersionId, existingMetadata.VersionId))
+	}
+
+	// ReplaceIds below must not modify the message, which is broadcast afterwards
+	payload := proto.Clone(msg.Payload).(*types.MsgUpdateDidPayload)
+	updatedDid := payload.ToDid()
+
+	if err := params.ValidateDidLimits(updatedDid); err != nil {
+		report.AddError(types.ErrDidDocLimitExceeded.Wrap(err.Error()))
+	}
+
+	if err := params.ValidateDidContext(updatedDid); err != nil {
+		report.AddError(types.ErrContextNotAllowed.Wrap(err.Error()))
+	}
+
+	signBytes, err := msg.Payload.GetSignBytesForMode(msg.SignMode)
+	if err != nil {
+		report.AddError(err)
+		return report
+	}
+
+	// Consider the new version of the DID a separate DID, as the ledger does
+	updatedDid.ReplaceIds(updatedDid.Id, updatedDid.Id+keeper.UpdatedPostfix)
+
+	resolveVersion := func(id string) (*types.Did, error) {
+		switch id {
+		case updatedDid.Id:
+			return &updatedDid, nil
+		case existingDid.Id:
+			return &existingDid, nil
+		default:
+			return resolve(id)
+		}
+	}
+
+	signers := keeper.GetSignerDIDsForDIDUpdate(existingDid, updatedDid)
+	extendedSignatures := keeper.DuplicateSignatures(msg.Signatures, existingDid.Id, updatedDid.Id)
+
+	for _, signer := range signers {
+		signerReport := SignerReport{
+			Signer: fmt.Sprint(keeper.GetSignerIdForErrorMessage(signer, existingDid.Id, updatedDid.Id)),
+			Status: SignerStatusMissing,
+		}
+
+		for _, signature := range types.FindSignInfosBySigner(extendedSignatures, signer) {
+			err := verifySignInfo(resolveVersion, params, signBytes, signature)
+			if err == nil {
+				signerReport.Status = SignerStatusValid
+				signerReport.Details = nil
+				break
+			}
+
+			signerReport.Status = SignerStatusInvalid
+			signerReport.Details = append(signerReport.Details, err.Error())
+		}
+
+		if signerReport.Status != SignerStatusValid {
+			report.Valid = false
+		}
+
+		report.Signers = append(report.Signers, signerReport)
+	}
+
+	return report
+}
+
+func verifySignInfo(resolve DidResolver, params types.Params, message []byte, signature types.SignInfo) error {
+	did, _, _, _ := utils.MustSplitDIDUrl(signature.VerificationMethodId)
+
+	didDoc, err := resolve(did)
+	if err != nil {
+		return err
+	}
+
+	for _, vm := range didDoc.GetAllVerificationMethods() {
+		if vm.Id != signature.VerificationMethodId {
+			continue
+		}
+
+		if !params.IsCapabilityInvocationMethod(*didDoc, vm.Id) {
+			return types.ErrUnauthorizedMethod.Wrapf("method id: %s", vm.Id)
+		}
+
+		signatureBytes, err := base64.StdEncoding.DecodeString(signature.Signature)
+		if err != nil {
+			return err
+		}
+
+		return types.VerifySignature(*vm, message, signatureBytes)
+	}
+
+	return types.ErrVerificationMethodNotFound.Wrap(signature.VerificationMethodId)
+}
+
+// QueryDryRunUpdateDid fetches the current state from the node and runs DryRunUpdateDid
+func QueryDryRunUpdateDid(clientCtx client.Context, msg *types.MsgUpdateDid) (DryRunReport, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	existing, err := queryClient.Did(context.Background(), &types.QueryGetDidRequest{Id: msg.Payload.Id})
+	if err != nil {
+		return DryRunReport{}, err
+	}
+
+	params, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	if err != nil {
+		return DryRunReport{}, err
+	}
+
+	block, err := queryDryRunBlock(clientCtx)
+	if err != nil {
+		return DryRunReport{}, err
+	}
+
+	cache := map[string]*types.Did{}
+	resolve := func(id string) (*types.Did, error) {
+		if did, found := cache[id]; found {
+			return did, nil
+		}
+
+		resp, err := queryClient.Did(context.Background(), &types.QueryGetDidRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+
+		cache[id] = resp.Did
+		return resp.Did, nil
+	}
+
+	return DryRunUpdateDid(msg, *existing.Did, *existing.Metadata, *params.Params, block, resolve), nil
+}
+
+// queryDryRunBlock returns the block following the latest one. Its time isn't known yet,
+// so the time of the latest block is used.
+func queryDryRunBlock(clientCtx client.Context) (DryRunBlock, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return DryRunBlock{}, err
+	}
+
+	status, err := node.Status(context.Background())
+	if err != nil {
+		return DryRunBlock{}, err
+	}
+
+	return DryRunBlock{
+		ChainId: status.NodeInfo.Network,
+		Height:  status.SyncInfo.LatestBlockHeight + 1,
+		Time:    status.SyncInfo.LatestBlockTime,
+	}, nil
+}
+
+// PrintDryRunReport prints the report as JSON
+func PrintDryRunReport(clientCtx client.Context, report DryRunReport) error {
+	bytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintString(string(bytes) + "\n")
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/multiformats/go-multibase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunUpdateDid(t *testing.T) {
+	did := "did:cheqd:test:aaaaaaaaaaaaaaaa"
+	keyId := did + "#key-1"
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherPrivKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	pubKeyMultibase, err := multibase.Encode(multibase.Base58BTC, pubKey)
+	require.NoError(t, err)
+
+	existingDid := types.Did{
+		Id:                 did,
+		VerificationMethod: []*types.VerificationMethod{types.NewVerificationMethod(keyId, types.Ed25519VerificationKey2020, did, nil, pubKeyMultibase)},
+		Authentication:     types.VerificationMethodReferences(keyId),
+	}
+	existingMetadata := types.Metadata{VersionId: "version-1"}
+
+	newMsg := func(versionId string, signWith ed25519.PrivateKey) *types.MsgUpdateDid {
+		payload := &types.MsgUpdateDidPayload{
+			Id:                 did,
+			VerificationMethod: existingDid.VerificationMethod,
+			Authentication:     existingDid.Authentication,
+			AssertionMethod:    types.VerificationMethodReferences(keyId),
+			VersionId:          versionId,
+		}
+
+		msg := &types.MsgUpdateDid{Payload: payload}
+		if signWith != nil {
+			msg.Signatures = []*types.SignInfo{{
+				VerificationMethodId: keyId,
+				Signature:            base64.StdEncoding.EncodeToString(ed25519.Sign(signWith, payload.GetSignBytes())),
+			}}
+		}
+
+		return msg
+	}
+
+	resolve := func(id string) (*types.Did, error) {
+		return nil, types.ErrDidDocNotFound.Wrap(id)
+	}
+
+	// The existing DID Doc has no capabilityInvocation, authentication authorizes changes with the fallback
+	fallbackParams := types.DefaultParams()
+	fallbackParams.AuthenticationFallback = true
+
+	block := DryRunBlock{ChainId: "cheqd", Height: 10, Time: time.Unix(1000, 0)}
+
+	t.Run("Valid: signed by the subject", func(t *testing.T) {
+		report := DryRunUpdateDid(newMsg("version-1", privKey), existingDid, existingMetadata, fallbackParams, block, resolve)
+
+		require.True(t, report.Valid)
+		require.Empty(t, report.Errors)
+		require.Equal(t, []SignerReport{
+			{Signer: did + " (old version)", Status: SignerStatusValid},
+			{Signer: did + " (new version)", Status: SignerStatusValid},
+		}, report.Signers)
+	})
+
+	t.Run("Not valid: signatures are missing", func(t *testing.T) {
+		report := DryRunUpdateDid(newMsg("version-1", nil), existingDid, existingMetadata, fallbackParams, block, resolve)
+
+		require.False(t, report.Valid)
+		require.Len(t, report.Signers, 2)
+		for _, signer := range report.Signers {
+			require.Equal(t, SignerStatusMissing, signer.Status)
+		}
+	})
+
+	t.Run("Not valid: signed by a wrong key", func(t *testing.T) {
+		report := DryRunUpdateDid(newMsg("version-1", otherPrivKey), existingDid, existingMetadata, fallbackParams, block, resolve)
+
+		require.False(t, report.Valid)
+		require.Len(t, report.Signers, 2)
+		for _, signer := range report.Signers {
+			require.Equal(t, SignerStatusInvalid, signer.Status)
+			require.Len(t, signer.Details, 1)
+		}
+	})
+
+	t.Run("Not valid: authentication is not authorized by default", func(t *testing.T) {
+		report := DryRunUpdateDid(newMsg("version-1", privKey), existingDid, existingMetadata, types.DefaultParams(), block, resolve)
+
+		require.False(t, report.Valid)
+		require.Len(t, report.Signers, 2)
+		for _, signer := range report.Signers {
+			require.Equal(t, SignerStatusInvalid, signer.Status)
+			require.Contains(t, signer.Details[0], types.ErrUnauthorizedMethod.Error())
+		}
+	})
+
+	t.Run("Not valid: unexpected version id", func(t *testing.T) {
+		report := DryRunUpdateDid(newMsg("version-0", privKey), existingDid, existingMetadata, fallbackParams, block, resolve)
+
+		require.False(t, report.Valid)
+		require.Len(t, report.Errors, 1)
+		require.Contains(t, report.Errors[0], types.ErrUnexpectedDidVersion.Error())
+	})
+
+	t.Run("Not valid: ledger checks", func(t *testing.T) {
+		deactivated := existingMetadata
+		deactivated.Deactivated = true
+
+		bound := existingDid
+		bound.BoundAccount = "cheqd1xlqy3y8k7yw4hktnhfw7mjm3k7uv3gaa0xjk3l"
+
+		cases := []struct {
+			name     string
+			modify   func(msg *types.MsgUpdateDid)
+			did      types.Did
+			metadata types.Metadata
+			err      error
+		}{
+			{"chain id mismatch", func(msg *types.MsgUpdateDid) { msg.Payload.ChainId = "other" }, existingDid, existingMetadata, types.ErrChainIdMismatch},
+			{"expired by height", func(msg *types.MsgUpdateDid) { msg.Payload.ExpiryHeight = 9 }, existingDid, existingMetadata, types.ErrPayloadExpired},
+			{"expired by time", func(msg *types.MsgUpdateDid) { msg.Payload.ExpiryTime = 999 }, existingDid, existingMetadata, types.ErrPayloadExpired},
+			{"deactivated", func(msg *types.MsgUpdateDid) {}, existingDid, deactivated, types.ErrDidDocDeactivated},
+			{"bound to another account", func(msg *types.MsgUpdateDid) {}, bound, existingMetadata, types.ErrUnexpectedSigner},
+		}
+
+		for _, tc := range cases {
+			msg := newMsg("version-1", privKey)
+			tc.modify(msg)
+
+			report := DryRunUpdateDid(msg, tc.did, tc.metadata, fallbackParams, block, resolve)
+
+			require.False(t, report.Valid, tc.name)
+			require.Contains(t, report.Errors[0], tc.err.Error(), tc.name)
+		}
+	})
+}
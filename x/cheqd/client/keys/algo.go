@@ -0,0 +1,68 @@
+package keys
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const P256Type = hd.PubKeyType(P256KeyType)
+
+var (
+	// Ed25519 is the algorithm of Ed25519VerificationKey2020 identity keys.
+	Ed25519 = ed25519Algo{}
+	// P256 is the algorithm of NIST P-256 JsonWebKey2020 identity keys.
+	P256 = p256Algo{}
+)
+
+// KeyringOption extends the keyring's supported algorithms with identity key algorithms. It's only used
+// for the keyring identity keys are generated with, Ed25519 and P-256 keys can't sign transactions
+// and must not be offered as account keys.
+func KeyringOption() keyring.Option {
+	return func(options *keyring.Options) {
+		options.SupportedAlgos = append(options.SupportedAlgos, Ed25519, P256)
+	}
+}
+
+// Identity keys reuse the secp256k1 BIP-32 derivation to get a secret from a mnemonic.
+// The secret is then used as a seed of the identity key.
+var deriveSecret = hd.Secp256k1.Derive()
+
+type ed25519Algo struct{}
+
+func (s ed25519Algo) Name() hd.PubKeyType {
+	return hd.Ed25519Type
+}
+
+func (s ed25519Algo) Derive() hd.DeriveFn {
+	return deriveSecret
+}
+
+func (s ed25519Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		return ed25519.GenPrivKeyFromSecret(bz)
+	}
+}
+
+type p256Algo struct{}
+
+func (s p256Algo) Name() hd.PubKeyType {
+	return P256Type
+}
+
+func (s p256Algo) Derive() hd.DeriveFn {
+	return deriveSecret
+}
+
+func (s p256Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		key, err := P256PrivKeyFromSecret(bz)
+		if err != nil {
+			// Can only happen if the secret is a multiple of the curve order
+			panic(err)
+		}
+
+		return key
+	}
+}
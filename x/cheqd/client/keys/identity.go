@@ -0,0 +1,139 @@
+package keys
+
+import (
+	"crypto/elliptic"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/multiformats/go-multibase"
+)
+
+// SupportedIdentityAlgos lists algorithms of keys that can be used to sign identity payloads
+var SupportedIdentityAlgos = []hd.PubKeyType{hd.Ed25519Type, hd.Secp256k1Type, P256Type}
+
+// Sign signs the message with a key stored in the keyring. The signature is encoded
+// the way the ledger expects it for the key type.
+func Sign(kr keyring.Keyring, uid string, msg []byte) ([]byte, error) {
+	sig, pubKey, err := kr.Sign(uid, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pubKey.(type) {
+	case *ed25519.PubKey, *secp256k1.PubKey, *P256PubKey:
+		// The keyring produces R || S for secp256k1 keys, the same as EcdsaSecp256k1VerificationKey2019 expects
+		return sig, nil
+	default:
+		return nil, fmt.Errorf("unsupported identity key type: %s", pubKey.Type())
+	}
+}
+
+// PrivKeyFromBytes builds an identity private key of the given algorithm from its raw representation
+func PrivKeyFromBytes(algo hd.PubKeyType, bz []byte) (cryptotypes.PrivKey, error) {
+	switch algo {
+	case hd.Ed25519Type:
+		if len(bz) != 64 {
+			return nil, fmt.Errorf("invalid ed25519 private key length: %d. must be 64", len(bz))
+		}
+
+		return &ed25519.PrivKey{Key: bz}, nil
+	case hd.Secp256k1Type:
+		if len(bz) != secp256k1.PrivKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 private key length: %d. must be %d", len(bz), secp256k1.PrivKeySize)
+		}
+
+		return &secp256k1.PrivKey{Key: bz}, nil
+	case P256Type:
+		if len(bz) != P256PrivKeySize {
+			return nil, fmt.Errorf("invalid p256 private key length: %d. must be %d", len(bz), P256PrivKeySize)
+		}
+
+		return &P256PrivKey{Key: bz}, nil
+	default:
+		return nil, fmt.Errorf("unsupported identity key algorithm: %s. supported algorithms are: %v", algo, SupportedIdentityAlgos)
+	}
+}
+
+// PubKeyMultibase encodes the public key the way it's used in public_key_multibase field
+// of verification methods. Only ed25519 and secp256k1 (compressed) keys can be represented in this form.
+func PubKeyMultibase(pubKey cryptotypes.PubKey) (string, error) {
+	switch pubKey.(type) {
+	case *ed25519.PubKey, *secp256k1.PubKey:
+		return multibase.Encode(multibase.Base58BTC, pubKey.Bytes())
+	default:
+		return "", fmt.Errorf("%s keys can't be represented as public key multibase", pubKey.Type())
+	}
+}
+
+// PubKeyJWK encodes the public key the way it's used in public_key_jwk field of verification methods
+func PubKeyJWK(pubKey cryptotypes.PubKey) ([]*types.KeyValuePair, error) {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	switch pubKey := pubKey.(type) {
+	case *ed25519.PubKey:
+		return []*types.KeyValuePair{
+			{Key: "kty", Value: "OKP"},
+			{Key: "crv", Value: "Ed25519"},
+			{Key: "x", Value: encode(pubKey.Key)},
+		}, nil
+	case *P256PubKey:
+		key, err := pubKey.ToECDSA()
+		if err != nil {
+			return nil, err
+		}
+
+		return ecJWK("P-256", key.X, key.Y, (elliptic.P256().Params().BitSize+7)/8), nil
+	default:
+		return nil, fmt.Errorf("unsupported identity key type: %s", pubKey.Type())
+	}
+}
+
+func ecJWK(crv string, x, y *big.Int, size int) []*types.KeyValuePair {
+	encode := func(i *big.Int) string {
+		bz := make([]byte, size)
+		i.FillBytes(bz)
+		return base64.RawURLEncoding.EncodeToString(bz)
+	}
+
+	return []*types.KeyValuePair{
+		{Key: "kty", Value: "EC"},
+		{Key: "crv", Value: crv},
+		{Key: "x", Value: encode(x)},
+		{Key: "y", Value: encode(y)},
+	}
+}
+
+// NewVerificationMethod builds a verification method for the public key. Ed25519 keys are represented as
+// Ed25519VerificationKey2020, secp256k1 keys as EcdsaSecp256k1VerificationKey2019, other keys as JsonWebKey2020.
+func NewVerificationMethod(id string, controller string, pubKey cryptotypes.PubKey) (*types.VerificationMethod, error) {
+	var multibaseType string
+	switch pubKey.(type) {
+	case *ed25519.PubKey:
+		multibaseType = types.Ed25519VerificationKey2020
+	case *secp256k1.PubKey:
+		multibaseType = types.EcdsaSecp256k1VerificationKey2019
+	}
+
+	if multibaseType != "" {
+		pubKeyMultibase, err := PubKeyMultibase(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewVerificationMethod(id, multibaseType, controller, nil, pubKeyMultibase), nil
+	}
+
+	pubKeyJwk, err := PubKeyJWK(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewVerificationMethod(id, types.JsonWebKey2020, controller, pubKeyJwk, ""), nil
+}
@@ -0,0 +1,104 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+)
+
+func NewTestKeyring(t *testing.T) keyring.Keyring {
+	kr, err := keyring.New("cheqd", keyring.BackendMemory, t.TempDir(), nil, KeyringOption())
+	require.NoError(t, err)
+	return kr
+}
+
+func TestSignVerifiableByLedger(t *testing.T) {
+	kr := NewTestKeyring(t)
+	msg := []byte("payload")
+
+	cases := []struct {
+		algo       keyring.SignatureAlgo
+		methodType string
+	}{
+		{Ed25519, "Ed25519VerificationKey2020"},
+		{hd.Secp256k1, "EcdsaSecp256k1VerificationKey2019"},
+		{P256, "JsonWebKey2020"},
+	}
+
+	for _, tc := range cases {
+		t.Run("Valid: "+string(tc.algo.Name()), func(t *testing.T) {
+			info, _, err := kr.NewMnemonic(string(tc.algo.Name()), keyring.English, "", "", tc.algo)
+			require.NoError(t, err)
+
+			signature, err := Sign(kr, info.GetName(), msg)
+			require.NoError(t, err)
+
+			vm := types.VerificationMethod{Type: tc.methodType}
+			if tc.methodType == "JsonWebKey2020" {
+				vm.PublicKeyJwk, err = PubKeyJWK(info.GetPubKey())
+			} else {
+				vm.PublicKeyMultibase, err = PubKeyMultibase(info.GetPubKey())
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, types.VerifySignature(vm, msg, signature))
+		})
+	}
+}
+
+func TestNewVerificationMethod(t *testing.T) {
+	kr := NewTestKeyring(t)
+
+	cases := []struct {
+		algo       keyring.SignatureAlgo
+		methodType string
+	}{
+		{Ed25519, "Ed25519VerificationKey2020"},
+		{hd.Secp256k1, "EcdsaSecp256k1VerificationKey2019"},
+		{P256, "JsonWebKey2020"},
+	}
+
+	for _, tc := range cases {
+		t.Run("Valid: "+string(tc.algo.Name()), func(t *testing.T) {
+			info, _, err := kr.NewMnemonic(string(tc.algo.Name()), keyring.English, "", "", tc.algo)
+			require.NoError(t, err)
+
+			vm, err := NewVerificationMethod("did:cheqd:testnet:123456789abcdefg#key-1", "did:cheqd:testnet:123456789abcdefg", info.GetPubKey())
+			require.NoError(t, err)
+			require.Equal(t, tc.methodType, vm.Type)
+			require.NoError(t, vm.Validate("", nil))
+		})
+	}
+}
+
+func TestImportIdentityKey(t *testing.T) {
+	kr := NewTestKeyring(t)
+
+	key, err := GenP256PrivKey()
+	require.NoError(t, err)
+
+	imported, err := PrivKeyFromBytes(P256Type, key.Bytes())
+	require.NoError(t, err)
+	require.True(t, key.Equals(imported))
+
+	_, err = PrivKeyFromBytes(P256Type, []byte{1, 2, 3})
+	require.Error(t, err)
+
+	_, err = PrivKeyFromBytes("sr25519", key.Bytes())
+	require.Error(t, err)
+
+	armor := crypto.EncryptArmorPrivKey(imported, "passphrase", string(P256Type))
+	require.NoError(t, kr.ImportPrivKey("imported", armor, "passphrase"))
+
+	info, err := kr.Key("imported")
+	require.NoError(t, err)
+	require.True(t, key.PubKey().Equals(info.GetPubKey()))
+
+	signature, err := Sign(kr, "imported", []byte("payload"))
+	require.NoError(t, err)
+	require.True(t, key.PubKey().VerifySignature([]byte("payload"), signature))
+}
@@ -0,0 +1,159 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/gogo/protobuf/proto"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	P256PrivKeyName = "cheqd/PrivKeyP256"
+	P256PubKeyName  = "cheqd/PubKeyP256"
+
+	P256KeyType     = "p256"
+	P256PrivKeySize = 32
+)
+
+var (
+	_ cryptotypes.PrivKey = &P256PrivKey{}
+	_ cryptotypes.PubKey  = &P256PubKey{}
+)
+
+func init() {
+	// The keyring stores keys using the legacy amino codec
+	legacy.Cdc.RegisterConcrete(&P256PubKey{}, P256PubKeyName, nil)
+	legacy.Cdc.RegisterConcrete(&P256PrivKey{}, P256PrivKeyName, nil)
+}
+
+// GenP256PrivKey generates a new P-256 private key using operating system randomness.
+func GenP256PrivKey() (*P256PrivKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := make([]byte, P256PrivKeySize)
+	key.D.FillBytes(bz)
+
+	return &P256PrivKey{Key: bz}, nil
+}
+
+// P256PrivKeyFromSecret builds a P-256 private key from arbitrary secret bytes
+// by reducing them modulo the curve order.
+func P256PrivKeyFromSecret(secret []byte) (*P256PrivKey, error) {
+	n := elliptic.P256().Params().N
+	d := new(big.Int).Mod(new(big.Int).SetBytes(secret), n)
+	if d.Sign() == 0 {
+		return nil, fmt.Errorf("invalid p256 private key")
+	}
+
+	bz := make([]byte, P256PrivKeySize)
+	d.FillBytes(bz)
+
+	return &P256PrivKey{Key: bz}, nil
+}
+
+// ToECDSA returns the key in the standard library representation.
+func (m *P256PrivKey) ToECDSA() *ecdsa.PrivateKey {
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(m.Key)
+	x, y := curve.ScalarBaseMult(m.Key)
+
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: curve, X: x, Y: y},
+		D:         d,
+	}
+}
+
+// Bytes implements SDK PrivKey interface.
+func (m *P256PrivKey) Bytes() []byte {
+	return m.Key
+}
+
+// Sign hashes the message with SHA-256 and signs the digest. The signature is ASN.1 encoded,
+// which is the encoding expected for EC keys of JsonWebKey2020 verification methods.
+func (m *P256PrivKey) Sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+	return ecdsa.SignASN1(rand.Reader, m.ToECDSA(), digest[:])
+}
+
+// PubKey implements SDK PrivKey interface.
+func (m *P256PrivKey) PubKey() cryptotypes.PubKey {
+	pub := m.ToECDSA().PublicKey
+	return &P256PubKey{Key: elliptic.MarshalCompressed(pub.Curve, pub.X, pub.Y)}
+}
+
+// Equals implements SDK PrivKey interface.
+func (m *P256PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	if m.Type() != other.Type() {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(m.Bytes(), other.Bytes()) == 1
+}
+
+// Type implements SDK PrivKey interface.
+func (m *P256PrivKey) Type() string {
+	return P256KeyType
+}
+
+// ToECDSA returns the key in the standard library representation.
+func (m *P256PubKey) ToECDSA() (*ecdsa.PublicKey, error) {
+	curve := elliptic.P256()
+	x, y := elliptic.UnmarshalCompressed(curve, m.Key)
+	if x == nil {
+		return nil, fmt.Errorf("invalid p256 public key")
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// String implements proto.Message interface.
+func (m *P256PubKey) String() string {
+	return fmt.Sprintf("PubKeyP256{%X}", m.Key)
+}
+
+// Address implements SDK PubKey interface.
+func (m *P256PubKey) Address() tmcrypto.Address {
+	return address.Hash(proto.MessageName(m), m.Key)
+}
+
+// Bytes implements SDK PubKey interface.
+func (m *P256PubKey) Bytes() []byte {
+	return m.Key
+}
+
+// VerifySignature verifies an ASN.1 encoded signature of the SHA-256 digest of the message.
+func (m *P256PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	pub, err := m.ToECDSA()
+	if err != nil {
+		return false
+	}
+
+	digest := sha256.Sum256(msg)
+	return ecdsa.VerifyASN1(pub, digest[:], sig)
+}
+
+// Equals implements SDK PubKey interface.
+func (m *P256PubKey) Equals(other cryptotypes.PubKey) bool {
+	if m.Type() != other.Type() {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(m.Bytes(), other.Bytes()) == 1
+}
+
+// Type implements SDK PubKey interface.
+func (m *P256PubKey) Type() string {
+	return P256KeyType
+}
@@ -0,0 +1,115 @@
+package resolver
+
+import "github.com/cheqd/cheqd-node/x/cheqd/types"
+
+// didDocument is the W3C DID Core representation of a DID Doc
+type didDocument struct {
+	Context              []string             `json:"@context,omitempty"`
+	Id                   string               `json:"id"`
+	Controller           []string             `json:"controller,omitempty"`
+	VerificationMethod   []verificationMethod `json:"verificationMethod,omitempty"`
+	Authentication       []interface{}        `json:"authentication,omitempty"`
+	AssertionMethod      []interface{}        `json:"assertionMethod,omitempty"`
+	CapabilityInvocation []interface{}        `json:"capabilityInvocation,omitempty"`
+	CapabilityDelegation []interface{}        `json:"capabilityDelegation,omitempty"`
+	KeyAgreement         []interface{}        `json:"keyAgreement,omitempty"`
+	Service              []service            `json:"service,omitempty"`
+	AlsoKnownAs          []string             `json:"alsoKnownAs,omitempty"`
+}
+
+type verificationMethod struct {
+	Id                 string            `json:"id"`
+	Type               string            `json:"type"`
+	Controller         string            `json:"controller"`
+	PublicKeyJwk       map[string]string `json:"publicKeyJwk,omitempty"`
+	PublicKeyMultibase string            `json:"publicKeyMultibase,omitempty"`
+}
+
+type service struct {
+	Id              string `json:"id"`
+	Type            string `json:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+}
+
+// documentMetadata is the DID document metadata defined by W3C DID Core
+type documentMetadata struct {
+	Created     string `json:"created,omitempty"`
+	Updated     string `json:"updated,omitempty"`
+	Deactivated bool   `json:"deactivated,omitempty"`
+	VersionId   string `json:"versionId,omitempty"`
+}
+
+// newDidDocument builds the DID Core representation. JSON-LD representations require the context,
+// which always starts with the DID Core context and includes contexts of the verification method types.
+func newDidDocument(did *types.Did, withContext bool) *didDocument {
+	doc := didDocument{
+		Id:                   did.Id,
+		Controller:           did.Controller,
+		Authentication:       newVerificationRelationship(did.Authentication),
+		AssertionMethod:      newVerificationRelationship(did.AssertionMethod),
+		CapabilityInvocation: newVerificationRelationship(did.CapabilityInvocation),
+		CapabilityDelegation: newVerificationRelationship(did.CapabilityDelegation),
+		KeyAgreement:         newVerificationRelationship(did.KeyAgreement),
+		AlsoKnownAs:          did.AlsoKnownAs,
+	}
+
+	if withContext {
+		doc.Context = did.GetJSONLDContext()
+	}
+
+	for _, vm := range did.VerificationMethod {
+		doc.VerificationMethod = append(doc.VerificationMethod, newVerificationMethod(vm))
+	}
+
+	for _, s := range did.Service {
+		doc.Service = append(doc.Service, service{Id: s.Id, Type: s.Type, ServiceEndpoint: s.ServiceEndpoint})
+	}
+
+	return &doc
+}
+
+func newVerificationMethod(vm *types.VerificationMethod) verificationMethod {
+	method := verificationMethod{
+		Id:                 vm.Id,
+		Type:               vm.Type,
+		Controller:         vm.Controller,
+		PublicKeyMultibase: vm.PublicKeyMultibase,
+	}
+
+	if len(vm.PublicKeyJwk) != 0 {
+		method.PublicKeyJwk = make(map[string]string, len(vm.PublicKeyJwk))
+		for _, kv := range vm.PublicKeyJwk {
+			method.PublicKeyJwk[kv.Key] = kv.Value
+		}
+	}
+
+	return method
+}
+
+// newVerificationRelationship represents references as strings and embedded methods as objects
+func newVerificationRelationship(vrs []*types.VerificationRelationship) []interface{} {
+	var result []interface{}
+
+	for _, vr := range vrs {
+		if vr.IsEmbedded() {
+			result = append(result, newVerificationMethod(vr.GetVerificationMethod()))
+		} else {
+			result = append(result, vr.GetVerificationMethodId())
+		}
+	}
+
+	return result
+}
+
+func newDocumentMetadata(metadata *types.Metadata) *documentMetadata {
+	if metadata == nil {
+		return &documentMetadata{}
+	}
+
+	return &documentMetadata{
+		Created:     metadata.Created,
+		Updated:     metadata.Updated,
+		Deactivated: metadata.Deactivated,
+		VersionId:   metadata.VersionId,
+	}
+}
@@ -0,0 +1,163 @@
+package resolver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+)
+
+const (
+	// ResolvePath is the path of the DID Resolution HTTP(S) binding, followed by the DID
+	ResolvePath = "/1.0/identifiers/"
+
+	DidLdJsonContentType  = "application/did+ld+json"
+	DidJsonContentType    = "application/did+json"
+	ResolutionProfile     = "https://w3id.org/did-resolution"
+	ResolutionContentType = `application/ld+json;profile="` + ResolutionProfile + `"`
+	ResolutionContext     = "https://w3id.org/did-resolution/v1"
+)
+
+// Errors of DID resolution metadata
+const (
+	ErrorInvalidDid                 = "invalidDid"
+	ErrorNotFound                   = "notFound"
+	ErrorRepresentationNotSupported = "representationNotSupported"
+	ErrorMethodNotSupported         = "methodNotSupported"
+	ErrorInternal                   = "internalError"
+)
+
+// resolutionResult is the DID resolution result of the DID Resolution HTTP(S) binding
+type resolutionResult struct {
+	Context               string             `json:"@context"`
+	DidDocument           *didDocument       `json:"didDocument"`
+	DidResolutionMetadata resolutionMetadata `json:"didResolutionMetadata"`
+	DidDocumentMetadata   *documentMetadata  `json:"didDocumentMetadata"`
+}
+
+type resolutionMetadata struct {
+	ContentType string `json:"contentType,omitempty"`
+	Error       string `json:"error,omitempty"`
+	// Height of the state the DID Doc is verified against, and the app hash of the state
+	Height  int64  `json:"height,omitempty"`
+	AppHash string `json:"appHash,omitempty"`
+}
+
+// ServeHTTP implements the DID Resolution HTTP(S) binding: GET /1.0/identifiers/{did}
+func (r *Resolver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !strings.HasPrefix(req.URL.Path, ResolvePath) {
+		http.NotFound(w, req)
+		return
+	}
+
+	id := strings.TrimPrefix(req.URL.Path, ResolvePath)
+
+	contentType, ok := negotiateContentType(req.Header.Get("Accept"))
+	if !ok {
+		writeResolutionError(w, http.StatusNotAcceptable, ErrorRepresentationNotSupported)
+		return
+	}
+
+	method, _, _, err := utils.TrySplitDID(id)
+	if err != nil {
+		writeResolutionError(w, http.StatusBadRequest, ErrorInvalidDid)
+		return
+	}
+
+	if method != types.DidMethod {
+		writeResolutionError(w, http.StatusNotImplemented, ErrorMethodNotSupported)
+		return
+	}
+
+	if err := utils.ValidateDID(id, types.DidMethod, nil); err != nil {
+		writeResolutionError(w, http.StatusBadRequest, ErrorInvalidDid)
+		return
+	}
+
+	resolution, err := r.Resolve(req.Context(), id)
+	if errors.Is(err, types.ErrDidDocNotFound) {
+		writeResolutionError(w, http.StatusNotFound, ErrorNotFound)
+		return
+	}
+
+	if err != nil {
+		// Responses of the node that can't be verified are never served
+		r.logger.Error("failed to resolve DID", "did", id, "err", err)
+		writeResolutionError(w, http.StatusInternalServerError, ErrorInternal)
+		return
+	}
+
+	status := http.StatusOK
+	if resolution.Metadata != nil && resolution.Metadata.Deactivated {
+		status = http.StatusGone
+	}
+
+	if contentType == DidLdJsonContentType || contentType == DidJsonContentType {
+		writeJSON(w, status, contentType, newDidDocument(resolution.Did, contentType == DidLdJsonContentType))
+		return
+	}
+
+	writeJSON(w, status, contentType, resolutionResult{
+		Context:     ResolutionContext,
+		DidDocument: newDidDocument(resolution.Did, true),
+		DidResolutionMetadata: resolutionMetadata{
+			ContentType: DidLdJsonContentType,
+			Height:      resolution.Height,
+			AppHash:     strings.ToUpper(hex.EncodeToString(resolution.AppHash)),
+		},
+		DidDocumentMetadata: newDocumentMetadata(resolution.Metadata),
+	})
+}
+
+// negotiateContentType returns the first supported media type of the Accept header
+func negotiateContentType(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return ResolutionContentType, true
+	}
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case DidLdJsonContentType, DidJsonContentType:
+			return mediaType, true
+		case "application/ld+json":
+			if profile, ok := params["profile"]; !ok || profile == ResolutionProfile {
+				return ResolutionContentType, true
+			}
+		case "application/json":
+			return mediaType, true
+		case "*/*", "application/*":
+			return ResolutionContentType, true
+		}
+	}
+
+	return "", false
+}
+
+func writeResolutionError(w http.ResponseWriter, status int, resolutionError string) {
+	writeJSON(w, status, ResolutionContentType, resolutionResult{
+		Context:               ResolutionContext,
+		DidResolutionMetadata: resolutionMetadata{Error: resolutionError},
+		DidDocumentMetadata:   &documentMetadata{},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, contentType string, body interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,192 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/client/verify"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// Resolution is a DID Doc verified against a header trusted by the light client
+type Resolution struct {
+	Did      *types.Did
+	Metadata *types.Metadata
+	// Height of the state the DID Doc is read from
+	Height int64
+	// App hash of the state from the header of the next block
+	AppHash []byte
+}
+
+// Resolver resolves DIDs using an untrusted node. Headers are verified by the light client,
+// DID Docs and their absence are verified by ICS-23 proofs against the app hashes of the headers.
+type Resolver struct {
+	lightClient *light.Client
+	node        rpcclient.ABCIClient
+	registry    cdctypes.InterfaceRegistry
+	logger      log.Logger
+
+	cache resolutionCache
+}
+
+func NewResolver(lightClient *light.Client, node rpcclient.ABCIClient, logger log.Logger) *Resolver {
+	registry := cdctypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+
+	return &Resolver{
+		lightClient: lightClient,
+		node:        node,
+		registry:    registry,
+		logger:      logger,
+	}
+}
+
+// Update verifies the latest header of the primary node, so that resolutions are served for the latest state
+func (r *Resolver) Update(ctx context.Context) error {
+	_, err := r.lightClient.Update(ctx, time.Now())
+	return err
+}
+
+// Run updates the light client with the interval until the context is done
+func (r *Resolver) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Update(ctx); err != nil {
+				r.logger.Error("failed to update the light client", "err", err)
+			}
+		}
+	}
+}
+
+// Resolve returns the DID Doc from the state proven by the latest trusted header.
+// Returns ErrDidDocNotFound if the absence of the DID Doc is proven.
+func (r *Resolver) Resolve(ctx context.Context, id string) (*Resolution, error) {
+	// The app hash of the state at height H is contained in the header H+1
+	lightBlock, err := r.lightClient.TrustedLightBlock(0)
+	if err != nil {
+		return nil, err
+	}
+
+	height := lightBlock.Height - 1
+
+	resolution, found := r.cache.get(height, id)
+	if !found {
+		resolution, err = r.resolveAt(ctx, id, height, lightBlock.AppHash)
+		if err != nil {
+			return nil, err
+		}
+
+		r.cache.put(height, id, resolution)
+	}
+
+	if resolution.Did == nil {
+		return nil, types.ErrDidDocNotFound.Wrap(id)
+	}
+
+	return resolution, nil
+}
+
+func (r *Resolver) resolveAt(ctx context.Context, id string, height int64, appHash []byte) (*Resolution, error) {
+	key := types.GetDidStoreKey(id)
+
+	res, err := r.node.ABCIQueryWithOptions(ctx, fmt.Sprintf("/store/%s/key", types.StoreKey), key,
+		rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.Response.IsOK() {
+		return nil, fmt.Errorf("failed to query DID Doc %s: %s", id, res.Response.Log)
+	}
+
+	if res.Response.Height != height {
+		return nil, types.ErrInvalidProof.Wrapf("response height is %d, expected: %d", res.Response.Height, height)
+	}
+
+	// Empty value is returned for missing keys along with the proof of absence
+	var value []byte
+	if len(res.Response.Value) != 0 {
+		value = res.Response.Value
+	}
+
+	if err := verify.StoreValue(res.Response.ProofOps, key, value, appHash); err != nil {
+		return nil, err
+	}
+
+	// Proven absence is cached as a resolution without DID Doc
+	resolution := Resolution{Height: height, AppHash: appHash}
+	if value == nil {
+		return &resolution, nil
+	}
+
+	var stateValue types.StateValue
+	if err := stateValue.Unmarshal(value); err != nil {
+		return nil, types.ErrUnpackStateValue.Wrap(err.Error())
+	}
+
+	if err := stateValue.UnpackInterfaces(r.registry); err != nil {
+		return nil, types.ErrUnpackStateValue.Wrap(err.Error())
+	}
+
+	did, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return nil, err
+	}
+
+	resolution.Did = did
+	resolution.Metadata = stateValue.Metadata
+
+	return &resolution, nil
+}
+
+// Max number of resolutions cached for a height
+const maxCachedResolutions = 10000
+
+// resolutionCache keeps verified resolutions of a single height, the latest one requested
+type resolutionCache struct {
+	mu          sync.Mutex
+	height      int64
+	resolutions map[string]*Resolution
+}
+
+func (c *resolutionCache) get(height int64, id string) (*Resolution, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height != c.height {
+		return nil, false
+	}
+
+	resolution, found := c.resolutions[id]
+	return resolution, found
+}
+
+func (c *resolutionCache) put(height int64, id string, resolution *Resolution) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height < c.height {
+		return
+	}
+
+	if height > c.height {
+		c.height = height
+		c.resolutions = make(map[string]*Resolution)
+	}
+
+	if len(c.resolutions) < maxCachedResolutions {
+		c.resolutions[id] = resolution
+	}
+}
@@ -0,0 +1,247 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cheqd/cheqd-node/app"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	dbs "github.com/tendermint/tendermint/light/store/db"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	testDid                = "did:cheqd:testnet:123456789abcdefg"
+	testPubKey             = "zF1hVGXXK9rmx5HhMTpGnGQJiab9qrFJbQXBRhSmYjQWX"
+	testKeyAgreementPubKey = "zDcCskRPRTix8toyKQ9VjXKaPNmiTZUY3f4J7pMPqWeK5"
+)
+
+func networkConfig(t *testing.T) network.Config {
+	encodingConfig := app.MakeEncodingConfig()
+
+	cfg := network.DefaultConfig()
+	cfg.Codec = encodingConfig.Codec
+	cfg.TxConfig = encodingConfig.TxConfig
+	cfg.LegacyAmino = encodingConfig.Amino
+	cfg.InterfaceRegistry = encodingConfig.InterfaceRegistry
+	cfg.GenesisState = app.ModuleBasics.DefaultGenesis(encodingConfig.Codec)
+	cfg.NumValidators = 1
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return app.New(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encodingConfig,
+			simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+
+	keyId := testDid + "#key-1"
+	did := types.Did{
+		Id: testDid,
+		VerificationMethod: []*types.VerificationMethod{
+			{
+				Id:                 keyId,
+				Type:               types.Ed25519VerificationKey2020,
+				Controller:         testDid,
+				PublicKeyMultibase: testPubKey,
+			},
+		},
+		Authentication: types.VerificationMethodReferences(keyId),
+		KeyAgreement: []*types.VerificationRelationship{
+			types.NewEmbeddedVerificationMethod(&types.VerificationMethod{
+				Id:                 testDid + "#key-2",
+				Type:               types.X25519KeyAgreementKey2020,
+				Controller:         testDid,
+				PublicKeyMultibase: testKeyAgreementPubKey,
+			}),
+		},
+	}
+
+	stateValue, err := types.NewStateValue(&did, &types.Metadata{Created: "2021-01-01T00:00:00Z", VersionId: "version"})
+	require.NoError(t, err)
+
+	genesis := types.DefaultGenesis()
+	genesis.DidList = []*types.StateValue{&stateValue}
+	cfg.GenesisState[types.ModuleName] = encodingConfig.Codec.MustMarshalJSON(genesis)
+
+	return cfg
+}
+
+// tamperingNode modifies responses of ABCI queries
+type tamperingNode struct {
+	rpcclient.ABCIClient
+	tamper func(value []byte) []byte
+}
+
+func (n tamperingNode) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	res, err := n.ABCIClient.ABCIQueryWithOptions(ctx, path, data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Response.Value = n.tamper(res.Response.Value)
+	return res, nil
+}
+
+func resolve(resolver *Resolver, id string, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, ResolvePath+id, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	rec := httptest.NewRecorder()
+	resolver.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestResolver(t *testing.T) {
+	net := network.New(t, networkConfig(t))
+	defer net.Cleanup()
+
+	_, err := net.WaitForHeight(3)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	val := net.Validators[0]
+
+	trustedHeight := int64(1)
+	commit, err := val.RPCClient.Commit(ctx, &trustedHeight)
+	require.NoError(t, err)
+
+	// The node is the primary and the witness of the light client
+	lightClient, err := light.NewHTTPClient(ctx, net.Config.ChainID,
+		light.TrustOptions{Period: time.Hour, Height: trustedHeight, Hash: commit.Hash()},
+		val.RPCAddress, []string{val.RPCAddress}, dbs.New(dbm.NewMemDB(), net.Config.ChainID),
+		light.Logger(log.NewNopLogger()))
+	require.NoError(t, err)
+
+	resolver := NewResolver(lightClient, val.RPCClient, log.NewNopLogger())
+	require.NoError(t, resolver.Update(ctx))
+
+	t.Run("Valid: DID Doc", func(t *testing.T) {
+		rec := resolve(resolver, testDid, DidLdJsonContentType)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, DidLdJsonContentType, rec.Header().Get("Content-Type"))
+
+		var doc didDocument
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+		require.Equal(t, testDid, doc.Id)
+		require.Equal(t, []string{
+			types.DidCoreContext,
+			"https://w3id.org/security/suites/ed25519-2020/v1",
+			"https://w3id.org/security/suites/x25519-2020/v1",
+		}, doc.Context)
+		require.Equal(t, testPubKey, doc.VerificationMethod[0].PublicKeyMultibase)
+
+		// References are strings, embedded methods are objects
+		require.Equal(t, []interface{}{testDid + "#key-1"}, doc.Authentication)
+		require.Equal(t, []interface{}{map[string]interface{}{
+			"id":                 testDid + "#key-2",
+			"type":               types.X25519KeyAgreementKey2020,
+			"controller":         testDid,
+			"publicKeyMultibase": testKeyAgreementPubKey,
+		}}, doc.KeyAgreement)
+	})
+
+	t.Run("Valid: DID resolution result", func(t *testing.T) {
+		rec := resolve(resolver, testDid, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, ResolutionContentType, rec.Header().Get("Content-Type"))
+
+		var result resolutionResult
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+		require.Equal(t, testDid, result.DidDocument.Id)
+		require.Equal(t, "version", result.DidDocumentMetadata.VersionId)
+		require.Equal(t, DidLdJsonContentType, result.DidResolutionMetadata.ContentType)
+		require.Positive(t, result.DidResolutionMetadata.Height)
+		require.NotEmpty(t, result.DidResolutionMetadata.AppHash)
+	})
+
+	t.Run("Valid: DID Doc is served from the cache", func(t *testing.T) {
+		first, err := resolver.Resolve(ctx, testDid)
+		require.NoError(t, err)
+
+		second, err := resolver.Resolve(ctx, testDid)
+		require.NoError(t, err)
+		require.Same(t, first, second)
+	})
+
+	t.Run("Valid: DID Doc is resolved at the latest trusted height", func(t *testing.T) {
+		before, err := resolver.Resolve(ctx, testDid)
+		require.NoError(t, err)
+
+		require.NoError(t, net.WaitForNextBlock())
+		require.NoError(t, resolver.Update(ctx))
+
+		after, err := resolver.Resolve(ctx, testDid)
+		require.NoError(t, err)
+		require.Greater(t, after.Height, before.Height)
+	})
+
+	cases := []struct {
+		name   string
+		id     string
+		accept string
+		status int
+		error  string
+	}{
+		{"Not valid: DID Doc not found", "did:cheqd:testnet:gfedcba987654321", "", http.StatusNotFound, ErrorNotFound},
+		{"Not valid: invalid DID", "did:cheqd:testnet:123", "", http.StatusBadRequest, ErrorInvalidDid},
+		{"Not valid: another DID method", "did:example:123456789abcdefg", "", http.StatusNotImplemented, ErrorMethodNotSupported},
+		{"Not valid: representation not supported", testDid, "text/html", http.StatusNotAcceptable, ErrorRepresentationNotSupported},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := resolve(resolver, tc.id, tc.accept)
+			require.Equal(t, tc.status, rec.Code)
+
+			var result resolutionResult
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			require.Equal(t, tc.error, result.DidResolutionMetadata.Error)
+			require.Nil(t, result.DidDocument)
+		})
+	}
+
+	tamperCases := []struct {
+		name   string
+		tamper func(value []byte) []byte
+	}{
+		{"Not valid: modified DID Doc", func(value []byte) []byte {
+			return append(value[:len(value)-1:len(value)-1], value[len(value)-1]^1)
+		}},
+		{"Not valid: hidden DID Doc", func(value []byte) []byte {
+			return nil
+		}},
+	}
+
+	for _, tc := range tamperCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tampered := NewResolver(lightClient, tamperingNode{ABCIClient: val.RPCClient, tamper: tc.tamper}, log.NewNopLogger())
+
+			_, err := tampered.Resolve(ctx, testDid)
+			require.ErrorIs(t, err, types.ErrInvalidProof, fmt.Sprint(err))
+
+			rec := resolve(tampered, testDid, "")
+			require.Equal(t, http.StatusInternalServerError, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package verify
+
+import (
+	"bytes"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// StoreValue checks the ICS-23 proof of a key-value pair of the cheqd module store against the app hash.
+// The value must be nil to check the proof of absence of the key.
+func StoreValue(proof *crypto.ProofOps, key []byte, value []byte, appHash []byte) error {
+	// IAVL proof of the key followed by the proof of the module store in the multistore
+	if proof == nil || len(proof.Ops) != 2 {
+		return types.ErrInvalidProof.Wrapf("expected proof of the key and proof of the store for key: %s", key)
+	}
+
+	storeHash, err := types.VerifyProofOp(&proof.Ops[0], storetypes.ProofOpIAVLCommitment, key, value)
+	if err != nil {
+		return err
+	}
+
+	root, err := types.VerifyProofOp(&proof.Ops[1], storetypes.ProofOpSimpleMerkleCommitment, types.KeyPrefix(types.StoreKey), storeHash)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(root, appHash) {
+		return types.ErrInvalidProof.Wrapf("key %s is proven against %X, not against the app hash", key, root)
+	}
+
+	return nil
+}
+
+// DidResponse checks that the stored value of the response is proven against the app hash of the state
+// at the response height, and that the DID Doc and the metadata of the response are decoded from it.
+func DidResponse(resp *types.QueryGetDidResponse, appHash []byte) error {
+	if resp.Did == nil {
+		return types.ErrInvalidProof.Wrap("DID Doc is missing")
+	}
+
+	if len(resp.Value) == 0 {
+		return types.ErrInvalidProof.Wrapf("stored value is missing for did: %s", resp.Did.Id)
+	}
+
+	// The proof is calculated for the bytes the keeper stores, which are proven as is
+	if err := StoreValue(resp.Proof, types.GetDidStoreKey(resp.Did.Id), resp.Value, appHash); err != nil {
+		return err
+	}
+
+	registry := cdctypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+
+	var stateValue types.StateValue
+	if err := stateValue.Unmarshal(resp.Value); err != nil {
+		return types.ErrUnpackStateValue.Wrap(err.Error())
+	}
+
+	if err := stateValue.UnpackInterfaces(registry); err != nil {
+		return types.ErrUnpackStateValue.Wrap(err.Error())
+	}
+
+	did, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return err
+	}
+
+	if !proto.Equal(did, resp.Did) || !proto.Equal(stateValue.Metadata, resp.Metadata) {
+		return types.ErrInvalidProof.Wrapf("DID Doc of the response doesn't match the stored value for did: %s", resp.Did.Id)
+	}
+
+	return nil
+}
+
+// DidResponseWithHeader checks the response against the trusted header of the block next to the response height,
+// which contains the app hash of the state the response is read from.
+func DidResponseWithHeader(resp *types.QueryGetDidResponse, header *tmtypes.Header) error {
+	if header.Height != resp.Height+1 {
+		return types.ErrInvalidProof.Wrapf("header height is %d, expected: %d", header.Height, resp.Height+1)
+	}
+
+	return DidResponse(resp, header.AppHash)
+}
@@ -2,15 +2,23 @@ package cheqd
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis initializes the cheqd module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Genesis validation is optional for node operators. DID Docs exported from a chain may have been
+	// written under older rules, so only the structure of the state is checked here.
+	if err := genState.ValidateBasic(); err != nil {
+		panic(fmt.Sprintf("Invalid cheqd genesis state: %s", err.Error()))
+	}
+
 	for _, elem := range genState.DidList {
 		did, err := elem.UnpackDataAsDid()
 		if err != nil {
@@ -26,21 +34,46 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.SetDidCount(&ctx, uint64(len(genState.DidList)))
 
 	k.SetDidNamespace(ctx, genState.DidNamespace)
+
+	k.SetParams(ctx, genState.GetParamsOrDefault())
+
+	// Bind to the IBC port. Genesis files created before IBC support may not contain it.
+	portId := genState.PortId
+	if portId == "" {
+		portId = types.PortID
+	}
+
+	// We may already own port capability from capability InitGenesis
+	if err := k.InitPort(ctx, portId); err != nil {
+		panic(fmt.Sprintf("Cannot claim port capability: %s", err.Error()))
+	}
 }
 
-// ExportGenesis returns the cheqd module's exported genesis.
-func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+// ExportGenesis writes the cheqd module's exported genesis as JSON.
+// DIDs are encoded one by one while iterating the store, so the DID list is never kept in memory as Go structs.
+// Whether the output is kept in memory as a whole depends on the writer.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONCodec, writer io.Writer) error {
 	genesis := types.DefaultGenesis()
 
-	// this line is used by starport scaffolding # genesis/module/export
-	// Get all did
-	didList := k.GetAllDid(&ctx)
-	for _, elem := range didList {
-		elem := elem
-		genesis.DidList = append(genesis.DidList, &elem)
+	genesis.DidNamespace = k.GetDidNamespace(ctx)
+	genesis.PortId = k.GetPort(ctx)
+
+	params := k.GetParams(ctx)
+	genesis.Params = &params
+
+	gw, err := types.NewGenesisJSONWriter(cdc, writer, *genesis)
+	if err != nil {
+		return err
 	}
 
-	genesis.DidNamespace = k.GetDidNamespace(ctx)
+	k.IterateDids(&ctx, func(stateValue types.StateValue) (stop bool) {
+		err = gw.WriteDid(&stateValue)
+		return err != nil
+	})
+
+	if err != nil {
+		return err
+	}
 
-	return genesis
+	return gw.Close()
 }
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.DidHooks = Keeper{}
+
+// SetHooks sets the hooks of modules that react to identity changes. Hooks can be set only once,
+// use types.NewMultiDidHooks to register several of them.
+func (k *Keeper) SetHooks(hooks types.DidHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set cheqd hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+func (k Keeper) AfterDidCreated(ctx sdk.Context, did *types.Did, metadata *types.Metadata) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.AfterDidCreated(ctx, did, metadata)
+}
+
+func (k Keeper) AfterDidUpdated(ctx sdk.Context, previous *types.Did, updated *types.Did, metadata *types.Metadata) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.AfterDidUpdated(ctx, previous, updated, metadata)
+}
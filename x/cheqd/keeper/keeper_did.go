@@ -100,6 +100,17 @@ func GetDidIDBytes(id string) []byte {
 
 // GetAllDid returns all did
 func (k Keeper) GetAllDid(ctx *sdk.Context) (list []types.StateValue) {
+	k.IterateDids(ctx, func(stateValue types.StateValue) (stop bool) {
+		list = append(list, stateValue)
+		return false
+	})
+
+	return
+}
+
+// IterateDids calls cb for each did in the store one by one, so that the whole store is never loaded
+// into memory. Iteration stops when cb returns true.
+func (k Keeper) IterateDids(ctx *sdk.Context, cb func(stateValue types.StateValue) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidKey))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 
@@ -113,8 +124,9 @@ func (k Keeper) GetAllDid(ctx *sdk.Context) (list []types.StateValue) {
 	for ; iterator.Valid(); iterator.Next() {
 		var val types.StateValue
 		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
-	}
 
-	return
+		if cb(val) {
+			break
+		}
+	}
 }
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateVerificationRelationships rewrites DID Docs stored with verification relationships as lists of ids,
+// so that the relationships are stored as lists of VerificationRelationship.
+func (k Keeper) MigrateVerificationRelationships(ctx *sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	// Keys are collected first, as the store must not be modified during iteration
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	err := iterator.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		stateValue, err := k.GetDid(ctx, string(key))
+		if err != nil {
+			return types.ErrInternal.Wrapf("did: %s, err: %s", key, err.Error())
+		}
+
+		// Legacy relationships are merged on unpacking
+		did, err := stateValue.UnpackDataAsDid()
+		if err != nil {
+			return types.ErrInternal.Wrapf("did: %s, err: %s", key, err.Error())
+		}
+
+		if err := k.SetDid(ctx, did, stateValue.Metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
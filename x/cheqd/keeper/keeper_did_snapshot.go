@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"io"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// ExportDidSnapshot writes a DID snapshot: all DID Docs and their count with ICS-23 proofs against the module
+// store hash, and the proof of the store hash against the app hash. Keys are read from the context, proofs are
+// queried from the committed multistore at the given height, so they must point to the same state.
+func (k Keeper) ExportDidSnapshot(ctx *sdk.Context, multistore sdk.Queryable, height int64, writer io.Writer) error {
+	snapshotWriter := types.NewDidSnapshotWriter(writer)
+
+	// The count is queried first, as its proof contains the store hash the header is built from
+	countItem, storeProof, err := k.queryDidSnapshotItem(multistore, height, types.GetDidCountStoreKey())
+	if err != nil {
+		return err
+	}
+
+	storeHash, err := types.VerifyProofOp(countItem.Proof, storetypes.ProofOpIAVLCommitment, countItem.Key, countItem.Value)
+	if err != nil {
+		return err
+	}
+
+	header := types.DidSnapshotHeader{
+		Format:     types.DidSnapshotFormat,
+		Height:     height,
+		StoreHash:  storeHash,
+		StoreProof: storeProof,
+	}
+
+	if err := snapshotWriter.WriteMsg(&header); err != nil {
+		return err
+	}
+
+	if err := snapshotWriter.WriteMsg(countItem); err != nil {
+		return err
+	}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidKey))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		item, _, err := k.queryDidSnapshotItem(multistore, height, iterator.Key())
+		if err != nil {
+			return err
+		}
+
+		if err := snapshotWriter.WriteMsg(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryDidSnapshotItem queries a key of the module store with proof. Returns the item and the proof of the store hash.
+func (k Keeper) queryDidSnapshotItem(multistore sdk.Queryable, height int64, key []byte) (*types.DidSnapshotItem, *crypto.ProofOp, error) {
+	value, proof, err := k.queryWithProof(multistore, height, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &types.DidSnapshotItem{Key: key, Value: value, Proof: &proof.Ops[0]}, &proof.Ops[1], nil
+}
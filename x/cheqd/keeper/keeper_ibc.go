@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// IsBound checks if the cheqd module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// InitPort stores the port id and claims the port capability if the module doesn't own it yet.
+// It's called from InitGenesis for new chains and from the upgrade handler for existing ones.
+func (k Keeper) InitPort(ctx sdk.Context, portID string) error {
+	k.SetPort(ctx, portID)
+
+	if k.IsBound(ctx, portID) {
+		return nil
+	}
+
+	return k.BindPort(ctx, portID)
+}
+
+// GetPort returns the portID for the cheqd module
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.KeyPrefix(types.PortKey)))
+}
+
+// SetPort sets the portID for the cheqd module
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.PortKey), []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the cheqd module to claim a capability that the IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// SendResolveDidPacket sends a request to resolve the DID on the chain connected through the source channel
+func (k Keeper) SendResolveDidPacket(ctx sdk.Context, sourcePort, sourceChannel string, id string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) (sequence uint64, err error) {
+	data := types.NewResolveDidPacketData(id)
+	if err := data.ValidateBasic(); err != nil {
+		return 0, types.ErrInvalidPacket.Wrap(err.Error())
+	}
+
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	sequence, found = k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "source port: %s, source channel: %s", sourcePort, sourceChannel)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		sourceChannelEnd.GetCounterparty().GetPortID(),
+		sourceChannelEnd.GetCounterparty().GetChannelID(),
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvResolveDidPacket resolves the requested DID from the state
+func (k Keeper) OnRecvResolveDidPacket(ctx sdk.Context, data types.ResolveDidPacketData) (types.ResolveDidPacketAck, error) {
+	if err := data.Validate(); err != nil {
+		return types.ResolveDidPacketAck{}, types.ErrInvalidPacket.Wrap(err.Error())
+	}
+
+	if !k.HasDid(&ctx, data.Id) {
+		return types.ResolveDidPacketAck{}, types.ErrDidDocNotFound.Wrap(data.Id)
+	}
+
+	stateValue, err := k.GetDid(&ctx, data.Id)
+	if err != nil {
+		return types.ResolveDidPacketAck{}, err
+	}
+
+	did, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return types.ResolveDidPacketAck{}, err
+	}
+
+	return types.ResolveDidPacketAck{Did: did, Metadata: stateValue.Metadata}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the current cheqd module parameters.
+// Parameters missing in the store (e.g. added by a later version) fall back to their defaults.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// SetParams sets the cheqd module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// queryWithProof queries a key of the module store from the committed multistore at the height.
+// Returns the value and the IAVL proof of the key followed by the proof of the module store against the app hash.
+func (k Keeper) queryWithProof(multistore sdk.Queryable, height int64, key []byte) ([]byte, *crypto.ProofOps, error) {
+	if multistore == nil {
+		return nil, nil, types.ErrInvalidProof.Wrap("store querier is not set, proofs are not supported")
+	}
+
+	res := multistore.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", k.storeKey.Name()),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+
+	if !res.IsOK() {
+		return nil, nil, fmt.Errorf("failed to query key %s: %s", key, res.Log)
+	}
+
+	if res.ProofOps == nil || len(res.ProofOps.Ops) != 2 {
+		return nil, nil, types.ErrInvalidProof.Wrapf("unexpected proof for key: %s", key)
+	}
+
+	return res.Value, res.ProofOps, nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ViewKeeper = Keeper{}
+
+// ViewKeeper is a read-only view of the cheqd keeper for other modules
+type ViewKeeper interface {
+	GetDid(ctx *sdk.Context, id string) (types.StateValue, error)
+	HasDid(ctx *sdk.Context, id string) bool
+	GetAllDid(ctx *sdk.Context) (list []types.StateValue)
+	IterateDids(ctx *sdk.Context, cb func(stateValue types.StateValue) (stop bool))
+	GetDidCount(ctx *sdk.Context) uint64
+	GetDidNamespace(ctx sdk.Context) string
+	GetParams(ctx sdk.Context) types.Params
+}
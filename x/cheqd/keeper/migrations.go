@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 migrates the store from consensus version 3 to 4: verification relationships are stored
+// as lists of VerificationRelationship, authentication keeps authorizing changes of existing DIDs
+// and the IBC port is claimed.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if err := m.keeper.MigrateVerificationRelationships(&ctx); err != nil {
+		return err
+	}
+
+	// DIDs written before version 4 have no capabilityInvocation. Authentication keeps authorizing their changes
+	// on existing chains until governance disables the fallback.
+	params := m.keeper.GetParams(ctx)
+	params.AuthenticationFallback = true
+	m.keeper.SetParams(ctx, params)
+
+	// InitGenesis doesn't run for existing chains, so the port is claimed here
+	return m.keeper.InitPort(ctx, types.PortID)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/base64"
+	"time"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
 	"github.com/cheqd/cheqd-node/x/cheqd/utils"
@@ -53,7 +54,10 @@ func MustFindDid(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.Stat
 }
 
 func FindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, didUrl string) (res types.VerificationMethod, found bool, err error) {
-	did, _, _, _ := utils.MustSplitDIDUrl(didUrl)
+	did, _, _, _, err := utils.TrySplitDIDUrl(didUrl)
+	if err != nil {
+		return types.VerificationMethod{}, false, err
+	}
 
 	stateValue, found, err := FindDid(k, ctx, inMemoryDIDs, did)
 	if err != nil || !found {
@@ -65,7 +69,7 @@ func FindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string
 		return types.VerificationMethod{}, false, err
 	}
 
-	for _, vm := range didDoc.VerificationMethod {
+	for _, vm := range didDoc.GetAllVerificationMethods() {
 		if vm.Id == didUrl {
 			return *vm, true, nil
 		}
@@ -81,18 +85,180 @@ func MustFindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[st
 	}
 
 	if !found {
-		return types.VerificationMethod{}, types.ErrVerificationMethodNotFound.Wrap(didUrl)
+		return types.VerificationMethod{}, types.WithDetails(types.ErrVerificationMethodNotFound.Wrap(didUrl), types.ErrorDetails{
+			VerificationMethodId: didUrl,
+		})
 	}
 
 	return res, nil
 }
 
+// ValidateDidLimits checks the DID Doc against the limits set by governance and charges gas
+// proportional to the document size.
+func ValidateDidLimits(k *Keeper, ctx *sdk.Context, did types.Did) error {
+	params := k.GetParams(*ctx)
+
+	if err := params.ValidateDidLimits(did); err != nil {
+		return types.ErrDidDocLimitExceeded.Wrap(err.Error())
+	}
+
+	ctx.GasMeter().ConsumeGas(params.DidDocByteCost*uint64(did.Size()), "cheqd: DID Doc size")
+
+	return nil
+}
+
+// ValidateDidContext checks the DID Doc contexts against the allow list set by governance
+func ValidateDidContext(k *Keeper, ctx *sdk.Context, did types.Did) error {
+	params := k.GetParams(*ctx)
+
+	if err := params.ValidateDidContext(did); err != nil {
+		return types.ErrContextNotAllowed.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// ValidateUniqueIdFormat checks that the unique id of a new DID is in one of the formats allowed by governance.
+// Self-certifying ids must be derived from a key of the DID Doc that signed the message, so that nobody can
+// claim an id derived from someone else's key. Signatures listed in verifiedMethods have already been verified
+// and aren't verified and charged again.
+func ValidateUniqueIdFormat(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, did types.Did, message []byte, signatures []*types.SignInfo, verifiedMethods []string) error {
+	params := k.GetParams(*ctx)
+
+	_, namespace, uniqueId, err := utils.TrySplitDID(did.Id)
+	if err != nil {
+		return types.ErrInvalidUniqueId.Wrap(err.Error())
+	}
+
+	for _, format := range params.UniqueIdFormats {
+		if types.ValidateUniqueIdFormat(uniqueId, format) != nil {
+			continue
+		}
+
+		if format != types.UniqueIdFormatSelfCertifying {
+			return nil
+		}
+
+		if VerifySelfCertifyingUniqueId(k, ctx, inMemoryDIDs, did, uniqueId, message, signatures, verifiedMethods) == nil {
+			return nil
+		}
+	}
+
+	return types.ErrInvalidUniqueId.Wrapf("unique id: %s, allowed formats in namespace %s: %v", uniqueId, namespace, params.UniqueIdFormats)
+}
+
+// VerifySelfCertifyingUniqueId checks that one of the signatures is made by a verification method
+// of the DID Doc whose key the unique id is derived from
+func VerifySelfCertifyingUniqueId(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, did types.Did, uniqueId string, message []byte, signatures []*types.SignInfo, verifiedMethods []string) error {
+	for _, vm := range did.GetAllVerificationMethods() {
+		derived, err := vm.SelfCertifyingUniqueId()
+		if err != nil || derived != uniqueId {
+			continue
+		}
+
+		if utils.Contains(verifiedMethods, vm.Id) {
+			return nil
+		}
+
+		for _, signature := range signatures {
+			if signature.VerificationMethodId != vm.Id {
+				continue
+			}
+
+			return VerifySignature(k, ctx, inMemoryDIDs, message, *signature)
+		}
+	}
+
+	return types.ErrInvalidUniqueId.Wrapf("no signature by the key the unique id is derived from: %s", uniqueId)
+}
+
+// ValidateReplayProtection checks that the payload is signed for this chain and hasn't expired
+func ValidateReplayProtection(ctx *sdk.Context, payload types.ReplayProtectedPayload) error {
+	return ValidateReplayProtectionAt(payload, ctx.ChainID(), ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// ValidateReplayProtectionAt checks the payload against the given chain and block. Clients use it to predict
+// the result of ValidateReplayProtection.
+func ValidateReplayProtectionAt(payload types.ReplayProtectedPayload, chainId string, height int64, blockTime time.Time) error {
+	if payload.GetChainId() != "" && payload.GetChainId() != chainId {
+		return types.ErrChainIdMismatch.Wrapf("got: %s, must be: %s", payload.GetChainId(), chainId)
+	}
+
+	if payload.GetExpiryHeight() != 0 && uint64(height) > payload.GetExpiryHeight() {
+		return types.ErrPayloadExpired.Wrapf("expiry height: %d, current height: %d", payload.GetExpiryHeight(), height)
+	}
+
+	if payload.GetExpiryTime() != 0 && uint64(blockTime.Unix()) > payload.GetExpiryTime() {
+		return types.ErrPayloadExpired.Wrapf("expiry time: %d, current time: %d", payload.GetExpiryTime(), blockTime.Unix())
+	}
+
+	return nil
+}
+
+// ValidateDidActive checks that the DID Doc isn't deactivated, deactivated DID Docs can't be changed
+func ValidateDidActive(id string, metadata *types.Metadata) error {
+	if metadata.Deactivated {
+		return types.ErrDidDocDeactivated.Wrap(id)
+	}
+
+	return nil
+}
+
+// ValidateBoundAccount checks that operations on a DID bound to an account are submitted by this account.
+// The signer is a signer of the message, so the account has to sign the transaction or grant
+// DidOperationAuthorization to the account executing it.
+func ValidateBoundAccount(did *types.Did, signer string) error {
+	if did.BoundAccount != "" && did.BoundAccount != signer {
+		return types.ErrUnexpectedSigner.Wrapf("did: %s, got: %s, must be: %s", did.Id, signer, did.BoundAccount)
+	}
+
+	return nil
+}
+
+// VerifyCapabilityInvocation checks that the verification method is authorized to change DID Docs
+// on behalf of the DID it belongs to
+func VerifyCapabilityInvocation(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, params types.Params, verificationMethodId string) error {
+	did, _, _, _, err := utils.TrySplitDIDUrl(verificationMethodId)
+	if err != nil {
+		return err
+	}
+
+	stateValue, err := MustFindDid(k, ctx, inMemoryDIDs, did)
+	if err != nil {
+		return err
+	}
+
+	didDoc, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return err
+	}
+
+	if !params.IsCapabilityInvocationMethod(*didDoc, verificationMethodId) {
+		return types.WithDetails(types.ErrUnauthorizedMethod.Wrapf("method id: %s", verificationMethodId), types.ErrorDetails{
+			VerificationMethodId: verificationMethodId,
+		})
+	}
+
+	return nil
+}
+
 func VerifySignature(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, message []byte, signature types.SignInfo) error {
 	verificationMethod, err := MustFindVerificationMethod(k, ctx, inMemoryDIDs, signature.VerificationMethodId)
 	if err != nil {
 		return err
 	}
 
+	params := k.GetParams(*ctx)
+
+	// Keys used for encryption or credential issuance must not control the DID
+	err = VerifyCapabilityInvocation(k, ctx, inMemoryDIDs, params, signature.VerificationMethodId)
+	if err != nil {
+		return err
+	}
+
+	// Charge gas before verification, so that invalid signatures are paid for as well
+	ctx.GasMeter().ConsumeGas(params.SigVerifyCost(verificationMethod), "cheqd: signature verification")
+
 	signatureBytes, err := base64.StdEncoding.DecodeString(signature.Signature)
 	if err != nil {
 		return err
@@ -100,7 +266,9 @@ func VerifySignature(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.
 
 	err = types.VerifySignature(verificationMethod, message, signatureBytes)
 	if err != nil {
-		return types.ErrInvalidSignature.Wrapf("method id: %s", signature.VerificationMethodId)
+		return types.WithDetails(types.ErrInvalidSignature.Wrapf("method id: %s", signature.VerificationMethodId), types.ErrorDetails{
+			VerificationMethodId: signature.VerificationMethodId,
+		})
 	}
 
 	return nil
@@ -11,24 +11,74 @@ import (
 func (k msgServer) CreateDid(goCtx context.Context, msg *types.MsgCreateDid) (*types.MsgCreateDidResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	did, metadata, err := CheckCreateDid(&k.Keeper, &ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	err = k.AppendDid(&ctx, did, metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	err = k.AfterDidCreated(ctx, did, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgCreateDidResponse{
+		Id: did.Id,
+	}, nil
+}
+
+// CheckCreateDid runs all checks of MsgCreateDid and returns the DID to store. The state isn't modified,
+// so the checks can be shared with the ante handler.
+func CheckCreateDid(k *Keeper, ctx *sdk.Context, msg *types.MsgCreateDid) (*types.Did, *types.Metadata, error) {
 	// Validate DID doesn't exist
-	if k.HasDid(&ctx, msg.Payload.Id) {
-		return nil, types.ErrDidDocExists.Wrap(msg.Payload.Id)
+	if k.HasDid(ctx, msg.Payload.Id) {
+		return nil, nil, types.ErrDidDocExists.Wrap(msg.Payload.Id)
 	}
 
 	// Validate namespaces
-	namespace := k.GetDidNamespace(ctx)
+	namespace := k.GetDidNamespace(*ctx)
 	err := msg.Validate([]string{namespace})
 	if err != nil {
-		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+		return nil, nil, types.WithDetails(types.ErrNamespaceValidation.Wrap(err.Error()), types.ValidationErrorDetails(err))
+	}
+
+	// Reject payloads signed for another chain or expired
+	err = ValidateReplayProtection(ctx, msg.Payload)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Build metadata and stateValue
 	did := msg.Payload.ToDid()
-	metadata := types.NewMetadataFromContext(ctx)
+
+	// Check the signer of DIDs bound to an account
+	err = ValidateBoundAccount(&did, msg.Signer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check limits and charge for storage
+	err = ValidateDidLimits(k, ctx, did)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check contexts against the allow list
+	err = ValidateDidContext(k, ctx, did)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := types.NewMetadataFromContext(*ctx)
 	stateValue, err := types.NewStateValue(&did, &metadata)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Consider did that we are going to create during did resolutions
@@ -37,37 +87,46 @@ func (k msgServer) CreateDid(goCtx context.Context, msg *types.MsgCreateDid) (*t
 	// Check controllers' existence
 	controllers := did.AllControllerDids()
 	for _, controller := range controllers {
-		_, err := MustFindDid(&k.Keeper, &ctx, inMemoryDids, controller)
+		_, err := MustFindDid(k, ctx, inMemoryDids, controller)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	// Verify signatures
+	signBytes, err := msg.Payload.GetSignBytesForMode(msg.SignMode)
+	if err != nil {
+		return nil, nil, types.ErrBasicValidation.Wrap(err.Error())
+	}
+
 	signers := GetSignerDIDsForDIDCreation(did)
+	verifiedMethods := make([]string, 0, len(signers))
 	for _, signer := range signers {
 		signature, found := types.FindSignInfoBySigner(msg.Signatures, signer)
 
 		if !found {
-			return nil, types.ErrSignatureNotFound.Wrapf("signer: %s", signer)
+			return nil, nil, types.WithDetails(types.ErrSignatureNotFound.Wrapf("signer: %s", signer), types.ErrorDetails{
+				Signer:          signer,
+				RequiredSigners: signers,
+				ProvidedSigners: types.GetSignInfoSigners(msg.Signatures),
+			})
 		}
 
-		err := VerifySignature(&k.Keeper, &ctx, inMemoryDids, msg.Payload.GetSignBytes(), signature)
+		err := VerifySignature(k, ctx, inMemoryDids, signBytes, signature)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+
+		verifiedMethods = append(verifiedMethods, signature.VerificationMethodId)
 	}
 
-	// Apply changes
-	err = k.AppendDid(&ctx, &did, &metadata)
+	// Check the unique id format, self-certifying ids require verified signatures
+	err = ValidateUniqueIdFormat(k, ctx, inMemoryDids, did, signBytes, msg.Signatures, verifiedMethods)
 	if err != nil {
-		return nil, types.ErrInternal.Wrapf(err.Error())
+		return nil, nil, err
 	}
 
-	// Build and return response
-	return &types.MsgCreateDidResponse{
-		Id: did.Id,
-	}, nil
+	return &did, &metadata, nil
 }
 
 func GetSignerDIDsForDIDCreation(did types.Did) []string {
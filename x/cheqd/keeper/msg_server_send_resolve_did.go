@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SendResolveDid(goCtx context.Context, msg *types.MsgSendResolveDid) (*types.MsgSendResolveDidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := k.SendResolveDidPacket(ctx, msg.SourcePort, msg.SourceChannel, msg.Id, msg.GetTimeoutHeight(), msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendResolveDidResponse{
+		Sequence: sequence,
+	}, nil
+}
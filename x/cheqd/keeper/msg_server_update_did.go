@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
 	"github.com/cheqd/cheqd-node/x/cheqd/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 const UpdatedPostfix string = "-updated"
@@ -13,48 +15,108 @@ const UpdatedPostfix string = "-updated"
 func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*types.MsgUpdateDidResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	existingDid, updatedDid, updatedMetadata, err := CheckUpdateDid(&k.Keeper, &ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	err = k.SetDid(&ctx, updatedDid, updatedMetadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	err = k.AfterDidUpdated(ctx, existingDid, updatedDid, updatedMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgUpdateDidResponse{
+		Id: updatedDid.Id,
+	}, nil
+}
+
+// CheckUpdateDid runs all checks of MsgUpdateDid and returns the existing and the updated DID. The state isn't modified,
+// so the checks can be shared with the ante handler.
+func CheckUpdateDid(k *Keeper, ctx *sdk.Context, msg *types.MsgUpdateDid) (*types.Did, *types.Did, *types.Metadata, error) {
 	// Validate DID does exist
-	if !k.HasDid(&ctx, msg.Payload.Id) {
-		return nil, types.ErrDidDocNotFound.Wrap(msg.Payload.Id)
+	if !k.HasDid(ctx, msg.Payload.Id) {
+		return nil, nil, nil, types.ErrDidDocNotFound.Wrap(msg.Payload.Id)
 	}
 
 	// Validate namespaces
-	namespace := k.GetDidNamespace(ctx)
+	namespace := k.GetDidNamespace(*ctx)
 	err := msg.Validate([]string{namespace})
 	if err != nil {
-		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+		return nil, nil, nil, types.WithDetails(types.ErrNamespaceValidation.Wrap(err.Error()), types.ValidationErrorDetails(err))
+	}
+
+	// Reject payloads signed for another chain or expired
+	err = ValidateReplayProtection(ctx, msg.Payload)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	// Retrieve existing state value and did
-	existingStateValue, err := k.GetDid(&ctx, msg.Payload.Id)
+	existingStateValue, err := k.GetDid(ctx, msg.Payload.Id)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	existingDid, err := existingStateValue.UnpackDataAsDid()
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	// Deactivated DID Docs can't be changed
+	err = ValidateDidActive(msg.Payload.Id, existingStateValue.Metadata)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Check the signer against the account the existing DID Doc is bound to
+	err = ValidateBoundAccount(existingDid, msg.Signer)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	// Check version id
 	if msg.Payload.VersionId != existingStateValue.Metadata.VersionId {
-		return nil, types.ErrUnexpectedDidVersion.Wrapf("got: %s, must be: %s", msg.Payload.VersionId, existingStateValue.Metadata.VersionId)
+		return nil, nil, nil, types.ErrUnexpectedDidVersion.Wrapf("got: %s, must be: %s", msg.Payload.VersionId, existingStateValue.Metadata.VersionId)
 	}
 
 	// Get sign bytes before modifying payload
-	signBytes := msg.Payload.GetSignBytes()
+	signBytes, err := msg.Payload.GetSignBytesForMode(msg.SignMode)
+	if err != nil {
+		return nil, nil, nil, types.ErrBasicValidation.Wrap(err.Error())
+	}
 
 	// Construct the new version of the DID and temporary rename it and its self references
-	// in order to consider old and new versions different DIDs during signatures validation
-	updatedDid := msg.Payload.ToDid()
+	// in order to consider old and new versions different DIDs during signatures validation.
+	// ReplaceIds must not modify the message.
+	updatedDid := proto.Clone(msg.Payload).(*types.MsgUpdateDidPayload).ToDid()
+
+	// Check limits and charge for storage
+	err = ValidateDidLimits(k, ctx, updatedDid)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Check contexts against the allow list
+	err = ValidateDidContext(k, ctx, updatedDid)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	updatedDid.ReplaceIds(updatedDid.Id, updatedDid.Id+UpdatedPostfix)
 
 	updatedMetadata := *existingStateValue.Metadata
-	updatedMetadata.Update(ctx)
+	updatedMetadata.Update(*ctx)
 
 	updatedStateValue, err := types.NewStateValue(&updatedDid, &updatedMetadata)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	// Consider the new version of the DID a separate DID
@@ -63,9 +125,9 @@ func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*t
 	// Check controllers existence
 	controllers := updatedDid.AllControllerDids()
 	for _, controller := range controllers {
-		_, err := MustFindDid(&k.Keeper, &ctx, inMemoryDids, controller)
+		_, err := MustFindDid(k, ctx, inMemoryDids, controller)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -76,14 +138,19 @@ func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*t
 	for _, signer := range signers {
 		signaturesBySigner := types.FindSignInfosBySigner(extendedSignatures, signer)
 		signerForErrorMessage := GetSignerIdForErrorMessage(signer, existingDid.Id, updatedDid.Id)
+		details := types.ErrorDetails{
+			Signer:          fmt.Sprint(signerForErrorMessage),
+			RequiredSigners: GetSignerIdsForErrorMessage(signers, existingDid.Id, updatedDid.Id),
+			ProvidedSigners: types.GetSignInfoSigners(msg.Signatures),
+		}
 
 		if len(signaturesBySigner) == 0 {
-			return nil, types.ErrSignatureNotFound.Wrapf("there should be at least one signature by %s", signerForErrorMessage)
+			return nil, nil, nil, types.WithDetails(types.ErrSignatureNotFound.Wrapf("there should be at least one signature by %s", signerForErrorMessage), details)
 		}
 
 		found := false
 		for _, signature := range signaturesBySigner {
-			err := VerifySignature(&k.Keeper, &ctx, inMemoryDids, signBytes, signature)
+			err := VerifySignature(k, ctx, inMemoryDids, signBytes, signature)
 			if err == nil {
 				found = true
 				break
@@ -91,21 +158,14 @@ func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*t
 		}
 
 		if !found {
-			return nil, types.ErrSignatureNotFound.Wrapf("there should be at least one valid signature by %s", signerForErrorMessage)
+			return nil, nil, nil, types.WithDetails(types.ErrSignatureNotFound.Wrapf("there should be at least one valid signature by %s", signerForErrorMessage), details)
 		}
 	}
 
-	// Apply changes: return original id and modify state
+	// Return original id
 	updatedDid.ReplaceIds(updatedDid.Id, existingDid.Id)
-	err = k.SetDid(&ctx, &updatedDid, &updatedMetadata)
-	if err != nil {
-		return nil, types.ErrInternal.Wrapf(err.Error())
-	}
 
-	// Build and return response
-	return &types.MsgUpdateDidResponse{
-		Id: updatedDid.Id,
-	}, nil
+	return existingDid, &updatedDid, &updatedMetadata, nil
 }
 
 func GetSignerIdForErrorMessage(signerId string, existingVersionId string, updatedVersionId string) interface{} {
@@ -120,6 +180,16 @@ func GetSignerIdForErrorMessage(signerId string, existingVersionId string, updat
 	return signerId
 }
 
+func GetSignerIdsForErrorMessage(signerIds []string, existingVersionId string, updatedVersionId string) []string {
+	res := make([]string, len(signerIds))
+
+	for i, signerId := range signerIds {
+		res[i] = fmt.Sprint(GetSignerIdForErrorMessage(signerId, existingVersionId, updatedVersionId))
+	}
+
+	return res
+}
+
 func DuplicateSignatures(signatures []*types.SignInfo, didToDuplicate string, newDid string) []*types.SignInfo {
 	var result []*types.SignInfo
 
@@ -144,10 +214,13 @@ func GetSignerDIDsForDIDUpdate(existingDid types.Did, updatedDid types.Did) []st
 	signers := existingDid.GetControllersOrSubject()
 	signers = append(signers, updatedDid.GetControllersOrSubject()...)
 
-	existingVMMap := types.VerificationMethodListToMapByFragment(existingDid.VerificationMethod)
-	updatedVMMap := types.VerificationMethodListToMapByFragment(updatedDid.VerificationMethod)
+	existingVMs := existingDid.GetAllVerificationMethods()
+	updatedVMs := updatedDid.GetAllVerificationMethods()
+
+	existingVMMap := types.VerificationMethodListToMapByFragment(existingVMs)
+	updatedVMMap := types.VerificationMethodListToMapByFragment(updatedVMs)
 
-	for _, updatedVM := range updatedDid.VerificationMethod {
+	for _, updatedVM := range updatedVMs {
 		_, _, _, fragment := utils.MustSplitDIDUrl(updatedVM.Id)
 		existingVM, found := existingVMMap[fragment]
 
@@ -168,7 +241,7 @@ func GetSignerDIDsForDIDUpdate(existingDid types.Did, updatedDid types.Did) []st
 		// VM not changed
 	}
 
-	for _, existingVM := range existingDid.VerificationMethod {
+	for _, existingVM := range existingVMs {
 		_, _, _, fragment := utils.MustSplitDIDUrl(existingVM.Id)
 		_, found := updatedVMMap[fragment]
 
@@ -26,5 +26,18 @@ func (k Keeper) Did(c context.Context, req *types.QueryGetDidRequest) (*types.Qu
 		return nil, err
 	}
 
-	return &types.QueryGetDidResponse{Did: did, Metadata: stateValue.Metadata}, nil
+	resp := types.QueryGetDidResponse{Did: did, Metadata: stateValue.Metadata}
+
+	if req.Prove {
+		value, proof, err := k.queryWithProof(k.storeQuerier, ctx.BlockHeight(), types.GetDidStoreKey(req.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Value = value
+		resp.Proof = proof
+		resp.Height = ctx.BlockHeight()
+	}
+
+	return &resp, nil
 }
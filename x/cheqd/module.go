@@ -1,6 +1,7 @@
 package cheqd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -120,7 +121,7 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (am AppModule) ConsensusVersion() uint64 {
-	return 3
+	return 4
 }
 
 // Name returns the capability module's name.
@@ -141,17 +142,24 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 	return keeper.NewQuerier(am.keeper, legacyQuerierCdc)
 }
 
-// RegisterServices registers a GRPC query service to respond to the
-// module-specific GRPC queries.
+// RegisterServices registers the Msg service, which authz uses to execute messages,
+// and a GRPC query service to respond to the module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration from version 3 to 4: %s", types.ModuleName, err.Error()))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // InitGenesis performs the capability module's genesis initialization It returns
-// no validator updates.
+// no validator updates. The module manager passes the genesis state as a single JSON message,
+// so the whole DID list is unmarshalled at once.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
 	var genState types.GenesisState
 	// Initialize global index to index in genesis state
@@ -163,9 +171,15 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 }
 
 // ExportGenesis returns the capability module's exported genesis state as raw JSON bytes.
+// The module manager expects a single JSON message, so the output is buffered as a whole: only the encoding
+// of DIDs is incremental. Use the export-dids command to stream DIDs of large stores to a file.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	genState := ExportGenesis(ctx, am.keeper)
-	return cdc.MustMarshalJSON(genState)
+	var buf bytes.Buffer
+	if err := ExportGenesis(ctx, am.keeper, cdc, &buf); err != nil {
+		panic(fmt.Sprintf("Cannot export %s genesis state: %s", types.ModuleName, err.Error()))
+	}
+
+	return buf.Bytes()
 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
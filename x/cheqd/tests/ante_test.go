@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/ante"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+)
+
+type TestTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx TestTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx TestTx) ValidateBasic() error {
+	return nil
+}
+
+func RunIdentityMsgDecorator(setup TestSetup, ctx sdk.Context, msgs ...sdk.Msg) error {
+	decorator := ante.NewIdentityMsgDecorator(setup.Keeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	_, err := decorator.AnteHandle(ctx, TestTx{msgs: msgs}, false, next)
+	return err
+}
+
+func TestIdentityMsgDecorator(t *testing.T) {
+	setup := Setup()
+	checkCtx := setup.Ctx.WithIsCheckTx(true)
+
+	aliceKeys, aliceDid, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	t.Run("Valid: create DID", func(t *testing.T) {
+		keyPair := GenerateKeyPair()
+		payload := setup.CreateDid(keyPair.PublicKey, BobDID)
+		msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{BobKey1: keyPair.PrivateKey})
+
+		require.NoError(t, RunIdentityMsgDecorator(setup, checkCtx, msg))
+		// Checks must not change the state
+		require.False(t, setup.Keeper.HasDid(&setup.Ctx, BobDID))
+	})
+
+	t.Run("Valid: update DID created in the same tx", func(t *testing.T) {
+		keyPair := GenerateKeyPair()
+		keys := map[string]ed25519.PrivateKey{BobKey1: keyPair.PrivateKey}
+		payload := setup.CreateDid(keyPair.PublicKey, BobDID)
+		createMsg := setup.WrapCreateRequest(payload, keys)
+
+		// The version id of a new DID is derived from the tx, so learn it by creating the DID in a throwaway context
+		cacheCtx, _ := checkCtx.CacheContext()
+		_, err := setup.Handler(cacheCtx, createMsg)
+		require.NoError(t, err)
+		state, err := setup.Keeper.GetDid(&cacheCtx, BobDID)
+		require.NoError(t, err)
+
+		updatePayload := setup.CreateToUpdateDid(payload)
+		updatePayload.VersionId = state.Metadata.VersionId
+		updatePayload.AlsoKnownAs = []string{"https://example.com/alias"}
+		updateMsg := setup.WrapUpdateRequest(updatePayload, MapToListOfSignerKeys(keys))
+
+		require.NoError(t, RunIdentityMsgDecorator(setup, checkCtx, createMsg, updateMsg))
+	})
+
+	t.Run("Not valid: wrong namespace", func(t *testing.T) {
+		did := "did:cheqd:mainnet:bbbbbbbbbbbbbbbb"
+		keyPair := GenerateKeyPair()
+		payload := setup.CreateDid(keyPair.PublicKey, did)
+		msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{did + "#key-1": keyPair.PrivateKey})
+
+		err := RunIdentityMsgDecorator(setup, checkCtx, msg)
+		require.ErrorIs(t, err, types.ErrNamespaceValidation)
+	})
+
+	t.Run("Not valid: DID already exists", func(t *testing.T) {
+		msg := setup.WrapCreateRequest(aliceDid, aliceKeys)
+
+		err := RunIdentityMsgDecorator(setup, checkCtx, msg)
+		require.ErrorIs(t, err, types.ErrDidDocExists)
+	})
+
+	t.Run("Not valid: invalid signature", func(t *testing.T) {
+		keyPair := GenerateKeyPair()
+		payload := setup.CreateDid(keyPair.PublicKey, BobDID)
+		msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{BobKey1: GenerateKeyPair().PrivateKey})
+
+		err := RunIdentityMsgDecorator(setup, checkCtx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+	})
+
+	t.Run("Not valid: unexpected version id", func(t *testing.T) {
+		payload := setup.CreateToUpdateDid(aliceDid)
+		payload.VersionId = "wrong"
+		msg := setup.WrapUpdateRequest(payload, MapToListOfSignerKeys(aliceKeys))
+
+		err := RunIdentityMsgDecorator(setup, checkCtx, msg)
+		require.ErrorIs(t, err, types.ErrUnexpectedDidVersion)
+	})
+
+	t.Run("Not valid: invalid signature in MsgExec", func(t *testing.T) {
+		keyPair := GenerateKeyPair()
+		payload := setup.CreateDid(keyPair.PublicKey, BobDID)
+		msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{BobKey1: GenerateKeyPair().PrivateKey})
+		exec := authz.NewMsgExec(sdk.AccAddress("grantee_____________"), []sdk.Msg{msg})
+
+		err := RunIdentityMsgDecorator(setup, checkCtx, &exec)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+	})
+
+	t.Run("Valid: checks are skipped in DeliverTx", func(t *testing.T) {
+		msg := setup.WrapCreateRequest(aliceDid, aliceKeys)
+
+		require.NoError(t, RunIdentityMsgDecorator(setup, setup.Ctx, msg))
+	})
+
+	t.Run("Valid: checks are skipped in ReCheckTx", func(t *testing.T) {
+		msg := setup.WrapCreateRequest(aliceDid, aliceKeys)
+
+		require.NoError(t, RunIdentityMsgDecorator(setup, checkCtx.WithIsReCheckTx(true), msg))
+	})
+}
+
+func TestIdentityMsgDecoratorDoesNotCallHooks(t *testing.T) {
+	hooks := &recordingHooks{}
+	setup := SetupWithHooks(hooks)
+
+	keyPair := GenerateKeyPair()
+	payload := setup.CreateDid(keyPair.PublicKey, AliceDID)
+	msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{AliceKey1: keyPair.PrivateKey})
+
+	require.NoError(t, RunIdentityMsgDecorator(setup, setup.Ctx.WithIsCheckTx(true), msg))
+	require.Empty(t, hooks.calls)
+}
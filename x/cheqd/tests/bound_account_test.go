@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundAccount(t *testing.T) {
+	account := sdk.AccAddress("bound_account_______").String()
+	another := sdk.AccAddress("another_account_____").String()
+
+	setup := Setup()
+	keyPair := GenerateKeyPair()
+	keys := map[string]ed25519.PrivateKey{AliceKey1: keyPair.PrivateKey}
+
+	payload := setup.CreateDid(keyPair.PublicKey, AliceDID)
+	payload.BoundAccount = account
+
+	createCases := []struct {
+		name   string
+		signer string
+		err    error
+	}{
+		{name: "Not valid: create without signer", err: types.ErrUnexpectedSigner},
+		{name: "Not valid: create by another account", signer: another, err: types.ErrUnexpectedSigner},
+		{name: "Valid: create by the bound account", signer: account},
+	}
+
+	for _, tc := range createCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := setup.WrapCreateRequest(payload, keys)
+			msg.Signer = tc.signer
+
+			_, err := setup.Handler(setup.Ctx, msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	did, err := state.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, account, did.BoundAccount)
+
+	updateCases := []struct {
+		name         string
+		signer       string
+		boundAccount string
+		err          error
+	}{
+		{name: "Not valid: update without signer", boundAccount: account, err: types.ErrUnexpectedSigner},
+		{name: "Not valid: update by another account", signer: another, boundAccount: account, err: types.ErrUnexpectedSigner},
+		// The bound account of the existing DID Doc is required even if the update rebinds the DID
+		{name: "Not valid: rebinding by the new account", signer: another, boundAccount: another, err: types.ErrUnexpectedSigner},
+		{name: "Valid: unbinding by the bound account", signer: account},
+		{name: "Valid: update of an unbound DID without signer"},
+	}
+
+	for _, tc := range updateCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+			require.NoError(t, err)
+
+			updatePayload := setup.CreateToUpdateDid(payload)
+			updatePayload.BoundAccount = tc.boundAccount
+			updatePayload.VersionId = state.Metadata.VersionId
+			msg := setup.WrapUpdateRequest(updatePayload, MapToListOfSignerKeys(keys))
+			msg.Signer = tc.signer
+
+			_, err = setup.Handler(setup.Ctx, msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBoundAccountMustBeAccAddress(t *testing.T) {
+	setup := Setup()
+	keyPair := GenerateKeyPair()
+
+	payload := setup.CreateDid(keyPair.PublicKey, AliceDID)
+	payload.BoundAccount = AliceDID
+
+	_, err := setup.SendCreateDid(payload, map[string]ed25519.PrivateKey{AliceKey1: keyPair.PrivateKey})
+	require.ErrorIs(t, err, types.ErrNamespaceValidation)
+	require.Contains(t, err.Error(), "bound_account")
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/stretchr/testify/require"
+)
+
+const customContext = "https://example.com/context/v1"
+
+func TestContextAllowList(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+
+	aliceDid := setup.CreateDid(keys[AliceKey1].PublicKey, AliceDID)
+	aliceDid.Context = []string{types.DidCoreContext, customContext}
+	signers := map[string]ed25519.PrivateKey{AliceKey1: keys[AliceKey1].PrivateKey}
+
+	_, err := setup.SendCreateDid(aliceDid, signers)
+	require.Error(t, err)
+	require.Equal(t, fmt.Sprintf("context: (1: context is not allowed.).: %s", types.ErrContextNotAllowed.Error()), err.Error())
+
+	// The context is allowed by governance
+	params := types.DefaultParams()
+	params.AllowedContexts = append(params.AllowedContexts, customContext)
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	receivedDid, err := setup.SendCreateDid(aliceDid, signers)
+	require.NoError(t, err)
+	require.Equal(t, aliceDid.Context, receivedDid.Context)
+
+	// Removing the context from the allow list doesn't affect stored DID Docs, but their updates
+	setup.Keeper.SetParams(setup.Ctx, types.DefaultParams())
+
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	_, err = setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}})
+	require.ErrorIs(t, err, types.ErrContextNotAllowed)
+
+	updatedDidDoc = setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.Context = []string{types.DidCoreContext}
+	receivedDid, err = setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.DidCoreContext}, receivedDid.Context)
+}
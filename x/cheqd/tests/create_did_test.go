@@ -33,7 +33,7 @@ func TestCreateDID(t *testing.T) {
 			signers: []string{ImposterKey1},
 			msg: &types.MsgCreateDidPayload{
 				Id:             ImposterDID,
-				Authentication: []string{ImposterKey1},
+				Authentication: types.VerificationMethodReferences(ImposterKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         ImposterKey1,
@@ -44,8 +44,8 @@ func TestCreateDID(t *testing.T) {
 			},
 		},
 		{
-			valid: true,
-			name:  "Valid: Works with Key Agreement",
+			valid: false,
+			name:  "Not Valid: Key Agreement can't authorize changes",
 			keys: map[string]KeyPair{
 				ImposterKey1: GenerateKeyPair(),
 				AliceKey1:    keys[AliceKey1],
@@ -53,7 +53,7 @@ func TestCreateDID(t *testing.T) {
 			signers: []string{ImposterKey1, AliceKey1},
 			msg: &types.MsgCreateDidPayload{
 				Id:           ImposterDID,
-				KeyAgreement: []string{ImposterKey1},
+				KeyAgreement: types.VerificationMethodReferences(ImposterKey1),
 				Controller:   []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
@@ -63,10 +63,11 @@ func TestCreateDID(t *testing.T) {
 					},
 				},
 			},
+			errMsg: fmt.Sprintf("method id: %s: verification method is not authorized for capability invocation", ImposterKey1),
 		},
 		{
-			valid: true,
-			name:  "Valid: Works with Assertion Method",
+			valid: false,
+			name:  "Not Valid: Assertion Method can't authorize changes",
 			keys: map[string]KeyPair{
 				ImposterKey1: GenerateKeyPair(),
 				AliceKey1:    keys[AliceKey1],
@@ -74,7 +75,7 @@ func TestCreateDID(t *testing.T) {
 			signers: []string{AliceKey1, ImposterKey1},
 			msg: &types.MsgCreateDidPayload{
 				Id:              ImposterDID,
-				AssertionMethod: []string{ImposterKey1},
+				AssertionMethod: types.VerificationMethodReferences(ImposterKey1),
 				Controller:      []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
@@ -84,10 +85,11 @@ func TestCreateDID(t *testing.T) {
 					},
 				},
 			},
+			errMsg: fmt.Sprintf("method id: %s: verification method is not authorized for capability invocation", ImposterKey1),
 		},
 		{
-			valid: true,
-			name:  "Valid: Works with Capability Delegation",
+			valid: false,
+			name:  "Not Valid: Capability Delegation can't authorize changes",
 			keys: map[string]KeyPair{
 				ImposterKey1: GenerateKeyPair(),
 				AliceKey1:    keys[AliceKey1],
@@ -95,7 +97,7 @@ func TestCreateDID(t *testing.T) {
 			signers: []string{AliceKey1, ImposterKey1},
 			msg: &types.MsgCreateDidPayload{
 				Id:                   ImposterDID,
-				CapabilityDelegation: []string{ImposterKey1},
+				CapabilityDelegation: types.VerificationMethodReferences(ImposterKey1),
 				Controller:           []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
@@ -105,6 +107,7 @@ func TestCreateDID(t *testing.T) {
 					},
 				},
 			},
+			errMsg: fmt.Sprintf("method id: %s: verification method is not authorized for capability invocation", ImposterKey1),
 		},
 		{
 			valid: true,
@@ -116,7 +119,7 @@ func TestCreateDID(t *testing.T) {
 			signers: []string{AliceKey1, ImposterKey1},
 			msg: &types.MsgCreateDidPayload{
 				Id:                   ImposterDID,
-				CapabilityInvocation: []string{ImposterKey1},
+				CapabilityInvocation: types.VerificationMethodReferences(ImposterKey1),
 				Controller:           []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
@@ -158,8 +161,7 @@ func TestCreateDID(t *testing.T) {
 				CharlieKey3:                             keys[CharlieKey3],
 			},
 			signers: []string{
-				"did:cheqd:test:1111111111111111#key-1",
-				"did:cheqd:test:1111111111111111#key-5",
+				"did:cheqd:test:1111111111111111#key-2",
 				AliceKey1,
 				BobKey1,
 				BobKey2,
@@ -170,15 +172,15 @@ func TestCreateDID(t *testing.T) {
 			},
 			msg: &types.MsgCreateDidPayload{
 				Id: "did:cheqd:test:1111111111111111",
-				Authentication: []string{
+				Authentication: types.VerificationMethodReferences(
 					"did:cheqd:test:1111111111111111#key-1",
 					"did:cheqd:test:1111111111111111#key-5",
-				},
-				Context:              []string{"abc", "de"},
-				CapabilityInvocation: []string{"did:cheqd:test:1111111111111111#key-2"},
-				CapabilityDelegation: []string{"did:cheqd:test:1111111111111111#key-3"},
-				KeyAgreement:         []string{"did:cheqd:test:1111111111111111#key-4"},
-				AlsoKnownAs:          []string{"SomeUri"},
+				),
+				Context:              []string{types.DidCoreContext, "https://w3id.org/security/suites/ed25519-2020/v1"},
+				CapabilityInvocation: types.VerificationMethodReferences("did:cheqd:test:1111111111111111#key-2"),
+				CapabilityDelegation: types.VerificationMethodReferences("did:cheqd:test:1111111111111111#key-3"),
+				KeyAgreement:         types.VerificationMethodReferences("did:cheqd:test:1111111111111111#key-4"),
+				AlsoKnownAs:          []string{"https://example.com/some-uri"},
 				Service: []*types.Service{
 					{
 						Id:              "did:cheqd:test:1111111111111111#service-1",
@@ -270,7 +272,7 @@ func TestCreateDID(t *testing.T) {
 			name:  "Not Valid: DID signed by wrong controller",
 			msg: &types.MsgCreateDidPayload{
 				Id:             ImposterDID,
-				Authentication: []string{ImposterKey1},
+				Authentication: types.VerificationMethodReferences(ImposterKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:                 ImposterKey1,
@@ -291,7 +293,7 @@ func TestCreateDID(t *testing.T) {
 			name:  "Not Valid: DID self-signed by not existing verification method",
 			msg: &types.MsgCreateDidPayload{
 				Id:             ImposterDID,
-				Authentication: []string{ImposterKey1},
+				Authentication: types.VerificationMethodReferences(ImposterKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:                 ImposterKey1,
@@ -313,7 +315,7 @@ func TestCreateDID(t *testing.T) {
 			msg: &types.MsgCreateDidPayload{
 				Id:             ImposterDID,
 				Controller:     []string{AliceDID, ImposterDID},
-				Authentication: []string{ImposterKey1},
+				Authentication: types.VerificationMethodReferences(ImposterKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:                 ImposterKey1,
@@ -339,7 +341,7 @@ func TestCreateDID(t *testing.T) {
 			signers: []string{CharlieKey1},
 			msg: &types.MsgCreateDidPayload{
 				Id:             CharlieDID,
-				Authentication: []string{CharlieKey1},
+				Authentication: types.VerificationMethodReferences(CharlieKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         CharlieKey1,
@@ -386,7 +388,7 @@ func TestCreateDID(t *testing.T) {
 				require.Equal(t, tc.msg.Context, did.Context)
 			} else {
 				require.Error(t, err)
-				require.Equal(t, tc.errMsg, err.Error())
+				require.Equal(t, tc.errMsg, types.TrimErrorDetails(err.Error()))
 			}
 		})
 	}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+)
+
+func exportDidSnapshot(t *testing.T, setup TestSetup) ([]byte, storetypes.CommitID) {
+	multistore := setup.Ctx.MultiStore().(storetypes.CommitMultiStore)
+	commitId := multistore.Commit()
+
+	var buf bytes.Buffer
+	err := setup.Keeper.ExportDidSnapshot(&setup.Ctx, multistore.(storetypes.Queryable), commitId.Version, &buf)
+	require.NoError(t, err)
+
+	return buf.Bytes(), commitId
+}
+
+// modifyDidSnapshot rewrites snapshot items, items are dropped if modify returns false
+func modifyDidSnapshot(t *testing.T, snapshot []byte, modify func(i int, item *types.DidSnapshotItem) bool) []byte {
+	reader := protoio.NewDelimitedReader(bytes.NewReader(snapshot), types.MaxDidSnapshotItemSize)
+
+	var buf bytes.Buffer
+	writer := types.NewDidSnapshotWriter(&buf)
+
+	var header types.DidSnapshotHeader
+	require.NoError(t, reader.ReadMsg(&header))
+	require.NoError(t, writer.WriteMsg(&header))
+
+	for i := 0; ; i++ {
+		var item types.DidSnapshotItem
+		err := reader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+
+		if modify(i, &item) {
+			require.NoError(t, writer.WriteMsg(&item))
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func TestDidSnapshot(t *testing.T) {
+	setup := Setup()
+
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+	_, _, err = setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	snapshot, commitId := exportDidSnapshot(t, setup)
+
+	var ids []string
+	header, err := types.VerifyDidSnapshot(bytes.NewReader(snapshot), commitId.Hash, func(stateValue types.StateValue) error {
+		did, err := stateValue.UnpackDataAsDid()
+		require.NoError(t, err)
+
+		ids = append(ids, did.Id)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, commitId.Version, header.Height)
+	require.Equal(t, []string{AliceDID, BobDID}, ids)
+
+	cases := []struct {
+		name     string
+		snapshot []byte
+		appHash  []byte
+		errorMsg string
+	}{
+		{
+			name:     "Not valid: another app hash",
+			snapshot: snapshot,
+			appHash:  []byte("another app hash"),
+			errorMsg: "not against the app hash",
+		},
+		{
+			name: "Not valid: DID Doc is dropped",
+			snapshot: modifyDidSnapshot(t, snapshot, func(i int, item *types.DidSnapshotItem) bool {
+				return i != 2
+			}),
+			appHash:  commitId.Hash,
+			errorMsg: "snapshot contains 1 DID Docs, expected: 2",
+		},
+		{
+			name: "Not valid: DID Doc is modified",
+			snapshot: modifyDidSnapshot(t, snapshot, func(i int, item *types.DidSnapshotItem) bool {
+				if i == 1 {
+					item.Value = append(item.Value, 0)
+				}
+
+				return true
+			}),
+			appHash:  commitId.Hash,
+			errorMsg: "proof did not verify existence of key",
+		},
+		{
+			name: "Not valid: proof of another DID Doc",
+			snapshot: modifyDidSnapshot(t, snapshot, func(i int, item *types.DidSnapshotItem) bool {
+				if i == 1 {
+					item.Key = types.GetDidStoreKey(CharlieDID)
+				}
+
+				return true
+			}),
+			appHash:  commitId.Hash,
+			errorMsg: "proof is for key",
+		},
+		{
+			name: "Not valid: count is missing",
+			snapshot: modifyDidSnapshot(t, snapshot, func(i int, item *types.DidSnapshotItem) bool {
+				return i != 0
+			}),
+			appHash:  commitId.Hash,
+			errorMsg: "DID Docs count is missing",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.VerifyDidSnapshot(bytes.NewReader(tc.snapshot), tc.appHash, nil)
+			require.ErrorIs(t, err, types.ErrInvalidProof)
+			require.Contains(t, err.Error(), tc.errorMsg)
+		})
+	}
+}
+
+func TestEmptyDidSnapshot(t *testing.T) {
+	setup := Setup()
+	snapshot, commitId := exportDidSnapshot(t, setup)
+
+	header, err := types.VerifyDidSnapshot(bytes.NewReader(snapshot), commitId.Hash, func(stateValue types.StateValue) error {
+		return errors.New("unexpected DID Doc")
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, commitId.Version, header.Height)
+}
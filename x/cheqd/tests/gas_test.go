@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDIDConsumesGasForSignaturesAndSize(t *testing.T) {
+	setup := Setup()
+	params := types.DefaultParams()
+
+	keyPair := GenerateKeyPair()
+	msg := setup.CreateDid(keyPair.PublicKey, AliceDID)
+	did := msg.ToDid()
+
+	setup.Ctx = setup.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := setup.SendCreateDid(msg, map[string]ed25519.PrivateKey{AliceKey1: keyPair.PrivateKey})
+	require.NoError(t, err)
+
+	minExpected := params.Ed25519SigVerifyCost + params.DidDocByteCost*uint64(did.Size())
+	require.GreaterOrEqual(t, setup.Ctx.GasMeter().GasConsumed(), minExpected)
+}
+
+func TestCreateDIDOutOfGas(t *testing.T) {
+	setup := Setup()
+
+	keyPair := GenerateKeyPair()
+	msg := setup.CreateDid(keyPair.PublicKey, AliceDID)
+
+	// Enough for KV reads but not for the signature verification
+	setup.Ctx = setup.Ctx.WithGasMeter(sdk.NewGasMeter(5000))
+
+	require.Panics(t, func() {
+		_, _ = setup.SendCreateDid(msg, map[string]ed25519.PrivateKey{AliceKey1: keyPair.PrivateKey})
+	})
+}
+
+func TestDIDLimits(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(params *types.Params)
+		errMsg string
+	}{
+		{
+			name:   "Not valid: too many verification methods",
+			modify: func(params *types.Params) { params.MaxVerificationMethods = 1 },
+			errMsg: "verification_method: the length must be no more than 1.",
+		},
+		{
+			name:   "Not valid: too many services",
+			modify: func(params *types.Params) { params.MaxServices = 1 },
+			errMsg: "service: the length must be no more than 1.",
+		},
+		{
+			name:   "Not valid: too many controllers",
+			modify: func(params *types.Params) { params.MaxControllers = 1 },
+			errMsg: "controller: the length must be no more than 1.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			keys := GenerateTestKeys()
+			require.NoError(t, setup.CreateTestDIDs(keys))
+
+			params := types.DefaultParams()
+			tc.modify(&params)
+			setup.Keeper.SetParams(setup.Ctx, params)
+
+			keyPair1 := GenerateKeyPair()
+			keyPair2 := GenerateKeyPair()
+			msg := setup.CreateDid(keyPair1.PublicKey, ImposterDID)
+			msg.Controller = []string{ImposterDID, AliceDID}
+			msg.VerificationMethod = append(msg.VerificationMethod, &types.VerificationMethod{
+				Id:                 ImposterKey2,
+				Type:               Ed25519VerificationKey2020,
+				Controller:         ImposterDID,
+				PublicKeyMultibase: setup.CreateDid(keyPair2.PublicKey, ImposterDID).VerificationMethod[0].PublicKeyMultibase,
+			})
+			msg.Service = append(msg.Service, &types.Service{
+				Id:              ImposterDID + "#service-3",
+				Type:            "LinkedDomains",
+				ServiceEndpoint: "endpoint",
+			})
+
+			signers := map[string]ed25519.PrivateKey{ImposterKey1: keyPair1.PrivateKey, AliceKey1: keys[AliceKey1].PrivateKey}
+			_, err := setup.SendCreateDid(msg, signers)
+			require.Error(t, err)
+			require.ErrorIs(t, err, types.ErrDidDocLimitExceeded)
+			require.Equal(t, fmt.Sprintf("%s: %s", tc.errMsg, types.ErrDidDocLimitExceeded.Error()), err.Error())
+		})
+	}
+}
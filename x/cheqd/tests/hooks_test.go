@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingHooks struct {
+	calls []string
+	err   error
+}
+
+func (h *recordingHooks) AfterDidCreated(_ sdk.Context, did *types.Did, _ *types.Metadata) error {
+	h.calls = append(h.calls, "created "+did.Id)
+	return h.err
+}
+
+func (h *recordingHooks) AfterDidUpdated(_ sdk.Context, previous *types.Did, updated *types.Did, _ *types.Metadata) error {
+	h.calls = append(h.calls, "updated "+previous.Id+" "+updated.Id)
+	return h.err
+}
+
+func SetupWithHooks(hooks ...types.DidHooks) TestSetup {
+	setup := Setup()
+	setup.Keeper.SetHooks(types.NewMultiDidHooks(hooks...))
+	setup.Handler = cheqd.NewHandler(setup.Keeper)
+
+	return setup
+}
+
+func TestDidHooks(t *testing.T) {
+	first, second := &recordingHooks{}, &recordingHooks{}
+	setup := SetupWithHooks(first, second)
+	keys := GenerateTestKeys()
+
+	aliceDid := setup.CreateDid(keys[AliceKey1].PublicKey, AliceDID)
+	_, err := setup.SendCreateDid(aliceDid, map[string]ed25519.PrivateKey{AliceKey1: keys[AliceKey1].PrivateKey})
+	require.NoError(t, err)
+
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.AlsoKnownAs = []string{"https://example.com/alice"}
+	_, err = setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}})
+	require.NoError(t, err)
+
+	expected := []string{"created " + AliceDID, "updated " + AliceDID + " " + AliceDID}
+	require.Equal(t, expected, first.calls)
+	require.Equal(t, expected, second.calls)
+}
+
+func TestDidHooksError(t *testing.T) {
+	hooks := &recordingHooks{err: errors.New("rejected by hook")}
+	setup := SetupWithHooks(hooks)
+
+	_, _, err := setup.InitDid(AliceDID)
+	require.EqualError(t, err, "rejected by hook")
+	require.Equal(t, []string{"created " + AliceDID}, hooks.calls)
+}
+
+func TestSetHooksTwice(t *testing.T) {
+	setup := SetupWithHooks()
+
+	require.Panics(t, func() {
+		setup.Keeper.SetHooks(types.NewMultiDidHooks())
+	})
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	cheqdapp "github.com/cheqd/cheqd-node/app"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// SetupCheqdTestingApp creates an app with its own home directory, as wasmvm locks the directory of contracts
+func SetupCheqdTestingApp(homePath string) (ibctesting.TestingApp, map[string]json.RawMessage) {
+	db := dbm.NewMemDB()
+	encCdc := cheqdapp.MakeEncodingConfig()
+	app := cheqdapp.New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, homePath, 5, encCdc, simapp.EmptyAppOptions{})
+	return app, cheqdapp.NewDefaultGenesisState(encCdc.Codec)
+}
+
+func SetupCheqdPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		return SetupCheqdTestingApp(t.TempDir())
+	}
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingApp })
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	coordinator.Setup(path)
+
+	return coordinator, path
+}
+
+// ResolveOverIBC sends a resolve packet from chain A and returns the acknowledgement written by chain B
+func ResolveOverIBC(t *testing.T, coordinator *ibctesting.Coordinator, path *ibctesting.Path, id string) channeltypes.Acknowledgement {
+	chainA := path.EndpointA.Chain
+	chainB := path.EndpointB.Chain
+
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+	msg := types.NewMsgSendResolveDid(chainA.SenderAccount.GetAddress().String(), path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID, id, timeoutHeight, 0)
+
+	sendRes, err := chainA.SendMsgs(msg)
+	require.NoError(t, err)
+
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, txMsgData.Unmarshal(sendRes.Data))
+	var sendResp types.MsgSendResolveDidResponse
+	require.NoError(t, sendResp.Unmarshal(txMsgData.Data[0].Data))
+	sequence := sendResp.Sequence
+
+	require.NoError(t, path.EndpointB.UpdateClient())
+
+	data := types.NewResolveDidPacketData(id)
+	packet := channeltypes.NewPacket(data.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := chainA.QueryProof(packetKey)
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, chainB.SenderAccount.GetAddress().String())
+
+	res, err := chainB.SendMsgs(recvMsg)
+	require.NoError(t, err)
+
+	ackBytes := FindAcknowledgement(t, res.GetEvents())
+	require.NotNil(t, ackBytes)
+
+	// The acknowledgement must be accepted by the sender chain
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.AcknowledgePacket(packet, ackBytes))
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, types.PacketCdc.UnmarshalJSON(ackBytes, &ack))
+
+	return ack
+}
+
+func FindAcknowledgement(t *testing.T, events []sdk.Event) []byte {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == channeltypes.AttributeKeyAck {
+				return attr.Value
+			}
+		}
+	}
+
+	t.Fatal("acknowledgement not found")
+	return nil
+}
+
+func TestIBCResolveDid(t *testing.T) {
+	coordinator, path := SetupCheqdPath(t)
+	chainB := path.EndpointB.Chain
+
+	// Create a DID on chain B
+	keeperB := chainB.App.(*cheqdapp.App).GetCheqdKeeper()
+	ctx := chainB.GetContext()
+
+	did := "did:cheqd:" + types.DefaultDidNamespace + ":aaaaaaaaaaaaaaaa"
+	keyPair := GenerateKeyPair()
+	payload := (&TestSetup{}).CreateDid(keyPair.PublicKey, did)
+	didDoc := payload.ToDid()
+	metadata := types.NewMetadataFromContext(ctx)
+
+	require.NoError(t, keeperB.AppendDid(&ctx, &didDoc, &metadata))
+	coordinator.CommitBlock(chainB)
+	require.NoError(t, path.EndpointA.UpdateClient())
+
+	t.Run("Valid: resolves existing DID", func(t *testing.T) {
+		ack := ResolveOverIBC(t, coordinator, path, did)
+		require.True(t, ack.Success())
+
+		var resolved types.ResolveDidPacketAck
+		require.NoError(t, types.PacketCdc.UnmarshalJSON(ack.GetResult(), &resolved))
+		// JSON decoding produces empty slices instead of nils, so compare binary encodings
+		expected, err := didDoc.Marshal()
+		require.NoError(t, err)
+		actual, err := resolved.Did.Marshal()
+		require.NoError(t, err)
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, metadata, *resolved.Metadata)
+	})
+
+	t.Run("Not valid: DID not found", func(t *testing.T) {
+		ack := ResolveOverIBC(t, coordinator, path, "did:cheqd:"+types.DefaultDidNamespace+":bbbbbbbbbbbbbbbb")
+		require.False(t, ack.Success())
+		require.Contains(t, ack.GetError(), types.ErrDidDocNotFound.Error())
+	})
+}
+
+func TestIBCSendResolveDidPacketValidation(t *testing.T) {
+	_, path := SetupCheqdPath(t)
+	chainA := path.EndpointA.Chain
+	keeperA := chainA.App.(*cheqdapp.App).GetCheqdKeeper()
+
+	_, err := keeperA.SendResolveDidPacket(chainA.GetContext(), path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID, "did:other:aaaaaaaaaaaaaaaa", clienttypes.NewHeight(0, 1000), 0)
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/client/verify"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDidQueryProof(t *testing.T) {
+	setup := Setup()
+
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	multistore := setup.Ctx.MultiStore().(storetypes.CommitMultiStore)
+	commitId := multistore.Commit()
+
+	ctx := setup.Ctx.WithBlockHeight(commitId.Version)
+	queryServer := keeper.NewQueryServer(setup.Keeper)
+
+	// Proof is optional
+	resp, err := queryServer.Did(sdk.WrapSDKContext(ctx), &types.QueryGetDidRequest{Id: AliceDID})
+	require.NoError(t, err)
+	require.Nil(t, resp.Proof)
+
+	resp, err = queryServer.Did(sdk.WrapSDKContext(ctx), &types.QueryGetDidRequest{Id: AliceDID, Prove: true})
+	require.NoError(t, err)
+	require.Equal(t, commitId.Version, resp.Height)
+	require.NoError(t, verify.DidResponse(resp, commitId.Hash))
+	require.NoError(t, verify.DidResponseWithHeader(resp, &tmtypes.Header{Height: resp.Height + 1, AppHash: commitId.Hash}))
+
+	// Header of another block
+	err = verify.DidResponseWithHeader(resp, &tmtypes.Header{Height: resp.Height, AppHash: commitId.Hash})
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	// Another app hash
+	err = verify.DidResponse(resp, []byte("another app hash"))
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	// Stored value of another DID Doc
+	value := resp.Value
+	resp.Value = append([]byte{}, value...)
+	resp.Value[len(resp.Value)-1]++
+	err = verify.DidResponse(resp, commitId.Hash)
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	// Stored value is missing
+	resp.Value = nil
+	err = verify.DidResponse(resp, commitId.Hash)
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+	resp.Value = value
+
+	// DID Doc that doesn't match the stored value
+	resp.Did.AlsoKnownAs = []string{"did:example:alice"}
+	err = verify.DidResponse(resp, commitId.Hash)
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	// Absence of a DID Doc
+	key := types.GetDidStoreKey(NotFounDID)
+	res := multistore.(storetypes.Queryable).Query(abci.RequestQuery{
+		Path:   "/" + types.StoreKey + "/key",
+		Data:   key,
+		Height: commitId.Version,
+		Prove:  true,
+	})
+	require.NoError(t, verify.StoreValue(res.ProofOps, key, nil, commitId.Hash))
+	require.ErrorIs(t, verify.StoreValue(res.ProofOps, key, []byte("value"), commitId.Hash), types.ErrInvalidProof)
+}
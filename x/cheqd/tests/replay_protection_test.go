@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayProtection(t *testing.T) {
+	cases := []struct {
+		name         string
+		chainId      string
+		expiryHeight uint64
+		expiryTime   uint64
+		err          error
+	}{
+		{name: "Valid: no replay protection"},
+		{name: "Valid: bound to the chain", chainId: "test"},
+		{name: "Valid: not expired", expiryHeight: 10, expiryTime: 1609459200},
+		{name: "Not valid: another chain", chainId: "fork", err: types.ErrChainIdMismatch},
+		{name: "Not valid: expired height", expiryHeight: 9, err: types.ErrPayloadExpired},
+		{name: "Not valid: expired time", expiryTime: 1609459199, err: types.ErrPayloadExpired},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			setup.Ctx = setup.Ctx.WithBlockHeight(10)
+
+			pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(t, err)
+			keys := map[string]ed25519.PrivateKey{AliceKey1: privKey}
+
+			// Create
+			payload := setup.CreateDid(pubKey, AliceDID)
+			payload.ChainId = tc.chainId
+			payload.ExpiryHeight = tc.expiryHeight
+			payload.ExpiryTime = tc.expiryTime
+
+			_, err = setup.SendCreateDid(payload, keys)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// Update
+			updatePayload := setup.CreateToUpdateDid(payload)
+			updatePayload.AlsoKnownAs = []string{"did:example:alice"}
+			updatePayload.ChainId = tc.chainId
+			updatePayload.ExpiryHeight = tc.expiryHeight
+			updatePayload.ExpiryTime = tc.expiryTime
+
+			_, err = setup.SendUpdateDid(updatePayload, MapToListOfSignerKeys(keys))
+			require.NoError(t, err)
+
+			// The same update is rejected once expired
+			if tc.expiryHeight != 0 {
+				setup.Ctx = setup.Ctx.WithBlockHeight(int64(tc.expiryHeight) + 1)
+				updatePayload.AlsoKnownAs = nil
+				updatePayload.VersionId = ""
+
+				_, err = setup.SendUpdateDid(updatePayload, MapToListOfSignerKeys(keys))
+				require.ErrorIs(t, err, types.ErrPayloadExpired)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -30,10 +32,11 @@ type KeyPair struct {
 }
 
 type TestSetup struct {
-	Cdc     codec.Codec
-	Ctx     sdk.Context
-	Keeper  keeper.Keeper
-	Handler sdk.Handler
+	Cdc      codec.Codec
+	Ctx      sdk.Context
+	StoreKey sdk.StoreKey
+	Keeper   keeper.Keeper
+	Handler  sdk.Handler
 }
 
 type SignerKey struct {
@@ -54,10 +57,16 @@ func Setup() TestSetup {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	dbStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
 
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	dbStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	newKeeper := keeper.NewKeeper(cdc, storeKey)
+	paramSpace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName)
+	newKeeper := keeper.NewKeeper(cdc, storeKey, paramSpace, nil, nil, capabilitykeeper.ScopedKeeper{}).SetStoreQuerier(dbStore.(sdk.Queryable))
 
 	// Create Tx
 	txBytes := make([]byte, 28)
@@ -72,13 +81,19 @@ func Setup() TestSetup {
 	handler := cheqd.NewHandler(*newKeeper)
 
 	setup := TestSetup{
-		Cdc:     cdc,
-		Ctx:     ctx,
-		Keeper:  *newKeeper,
-		Handler: handler,
+		Cdc:      cdc,
+		Ctx:      ctx,
+		StoreKey: storeKey,
+		Keeper:   *newKeeper,
+		Handler:  handler,
 	}
 
+	// Test DIDs authorize changes by authentication, like DIDs written before capabilityInvocation was checked
+	params := types.DefaultParams()
+	params.AuthenticationFallback = true
+
 	setup.Keeper.SetDidNamespace(ctx, "test")
+	setup.Keeper.SetParams(ctx, params)
 	return setup
 }
 
@@ -102,13 +117,13 @@ func (s *TestSetup) CreateDid(pubKey ed25519.PublicKey, did string) *types.MsgCr
 		Id:                   did,
 		Controller:           nil,
 		VerificationMethod:   []*types.VerificationMethod{&VerificationMethod},
-		Authentication:       []string{did + "#key-1"},
-		AssertionMethod:      []string{did + "#key-1"},
-		CapabilityInvocation: []string{did + "#key-1"},
-		CapabilityDelegation: []string{did + "#key-1"},
-		KeyAgreement:         []string{did + "#key-1"},
+		Authentication:       types.VerificationMethodReferences(did + "#key-1"),
+		AssertionMethod:      types.VerificationMethodReferences(did + "#key-1"),
+		CapabilityInvocation: types.VerificationMethodReferences(did + "#key-1"),
+		CapabilityDelegation: types.VerificationMethodReferences(did + "#key-1"),
+		KeyAgreement:         types.VerificationMethodReferences(did + "#key-1"),
 		AlsoKnownAs:          []string{did + "#key-1"},
-		Context:              []string{"Context"},
+		Context:              []string{types.DidCoreContext},
 		Service:              []*types.Service{&Service},
 	}
 }
@@ -126,6 +141,7 @@ func (s *TestSetup) CreateToUpdateDid(did *types.MsgCreateDidPayload) *types.Msg
 		AlsoKnownAs:          did.AlsoKnownAs,
 		Service:              did.Service,
 		Context:              did.Context,
+		BoundAccount:         did.BoundAccount,
 	}
 }
 
@@ -246,7 +262,7 @@ func (s TestSetup) CreateTestDIDs(keys map[string]KeyPair) error {
 			signers: []string{AliceKey1},
 			msg: &types.MsgCreateDidPayload{
 				Id:             AliceDID,
-				Authentication: []string{AliceKey1},
+				Authentication: types.VerificationMethodReferences(AliceKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -260,14 +276,14 @@ func (s TestSetup) CreateTestDIDs(keys map[string]KeyPair) error {
 			signers: []string{BobKey2},
 			msg: &types.MsgCreateDidPayload{
 				Id: BobDID,
-				Authentication: []string{
+				Authentication: types.VerificationMethodReferences(
 					BobKey1,
 					BobKey2,
 					BobKey3,
-				},
-				CapabilityDelegation: []string{
+				),
+				CapabilityDelegation: types.VerificationMethodReferences(
 					BobKey4,
-				},
+				),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         BobKey1,
@@ -296,11 +312,11 @@ func (s TestSetup) CreateTestDIDs(keys map[string]KeyPair) error {
 			signers: []string{CharlieKey2, BobKey2},
 			msg: &types.MsgCreateDidPayload{
 				Id: CharlieDID,
-				Authentication: []string{
+				Authentication: types.VerificationMethodReferences(
 					CharlieKey1,
 					CharlieKey2,
 					CharlieKey3,
-				},
+				),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         CharlieKey1,
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/stretchr/testify/require"
+)
+
+func SignJCS(t *testing.T, payload types.IdentityMsg, vmId string, key ed25519.PrivateKey) []*types.SignInfo {
+	signBytes, err := payload.GetSignBytesForMode(types.SignMode_SIGN_MODE_JCS)
+	require.NoError(t, err)
+
+	return []*types.SignInfo{{
+		VerificationMethodId: vmId,
+		Signature:            base64.StdEncoding.EncodeToString(ed25519.Sign(key, signBytes)),
+	}}
+}
+
+func TestJCSSignMode(t *testing.T) {
+	setup := Setup()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload := setup.CreateDid(pubKey, AliceDID)
+
+	// Canonical JSON form doesn't depend on protobuf encoding
+	signBytes, err := payload.GetSignBytesForMode(types.SignMode_SIGN_MODE_JCS)
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), fmt.Sprintf(`"id":"%s"`, AliceDID))
+
+	// Binary sign mode doesn't accept JCS signatures
+	_, err = setup.Handler(setup.Ctx, &types.MsgCreateDid{
+		Payload:    payload,
+		Signatures: SignJCS(t, payload, AliceKey1, privKey),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// Create
+	_, err = setup.Handler(setup.Ctx, &types.MsgCreateDid{
+		Payload:    payload,
+		Signatures: SignJCS(t, payload, AliceKey1, privKey),
+		SignMode:   types.SignMode_SIGN_MODE_JCS,
+	})
+	require.NoError(t, err)
+
+	// Update
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	updatePayload := setup.CreateToUpdateDid(payload)
+	updatePayload.AlsoKnownAs = []string{"did:example:alice"}
+	updatePayload.VersionId = state.Metadata.VersionId
+
+	_, err = setup.Handler(setup.Ctx, &types.MsgUpdateDid{
+		Payload:    updatePayload,
+		Signatures: SignJCS(t, updatePayload, AliceKey1, privKey),
+		SignMode:   types.SignMode_SIGN_MODE_JCS,
+	})
+	require.NoError(t, err)
+
+	updated, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	did, err := updated.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, []string{"did:example:alice"}, did.AlsoKnownAs)
+
+	// Unknown sign mode
+	_, err = setup.Handler(setup.Ctx, &types.MsgUpdateDid{
+		Payload:    updatePayload,
+		Signatures: SignJCS(t, updatePayload, AliceKey1, privKey),
+		SignMode:   types.SignMode(100),
+	})
+	require.Error(t, err)
+
+	details, found := types.GetErrorDetails(err)
+	require.True(t, found)
+	require.Equal(t, "sign_mode", details.Fields[0].Path)
+}
+
+func TestJCSSignBytesIgnoreEmptyLists(t *testing.T) {
+	setup := Setup()
+
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload := setup.CreateDid(pubKey, AliceDID)
+	payload.Service = nil
+	payload.AlsoKnownAs = nil
+	payload.VerificationMethod[0].PublicKeyJwk = nil
+
+	signBytes, err := payload.GetSignBytesForMode(types.SignMode_SIGN_MODE_JCS)
+	require.NoError(t, err)
+
+	// Decoded payloads contain empty lists instead of nil ones
+	decoded := *payload
+	decoded.Service = []*types.Service{}
+	decoded.AlsoKnownAs = []string{}
+	decodedVm := *payload.VerificationMethod[0]
+	decodedVm.PublicKeyJwk = []*types.KeyValuePair{}
+	decoded.VerificationMethod = []*types.VerificationMethod{&decodedVm}
+
+	decodedSignBytes, err := decoded.GetSignBytesForMode(types.SignMode_SIGN_MODE_JCS)
+	require.NoError(t, err)
+	require.Equal(t, string(signBytes), string(decodedSignBytes))
+	require.NotContains(t, string(signBytes), "[]")
+}
@@ -42,7 +42,7 @@ func TestDIDDocVerificationMethodChangedWithoutOldSignature(t *testing.T) {
 
 	// check
 	require.Error(t, err)
-	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), err.Error())
+	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), types.TrimErrorDetails(err.Error()))
 }
 
 func TestDIDDocVerificationMethodControllerChangedWithoutOldSignature(t *testing.T) {
@@ -58,7 +58,13 @@ func TestDIDDocVerificationMethodControllerChangedWithoutOldSignature(t *testing
 
 	// check
 	require.Error(t, err)
-	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), err.Error())
+	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), types.TrimErrorDetails(err.Error()))
+
+	details, found := types.GetErrorDetails(err)
+	require.True(t, found)
+	require.Equal(t, AliceDID+" (old version)", details.Signer)
+	require.Equal(t, []string{AliceDID + " (old version)", AliceDID + " (new version)", BobDID}, details.RequiredSigners)
+	require.Equal(t, []string{BobDID}, details.ProvidedSigners)
 }
 
 func TestDIDDocControllerChangedWithoutOldSignature(t *testing.T) {
@@ -74,7 +80,7 @@ func TestDIDDocControllerChangedWithoutOldSignature(t *testing.T) {
 
 	// check
 	require.Error(t, err)
-	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), err.Error())
+	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), types.TrimErrorDetails(err.Error()))
 }
 
 func TestDIDDocVerificationMethodDeletedWithoutOldSignature(t *testing.T) {
@@ -101,12 +107,12 @@ func TestDIDDocVerificationMethodDeletedWithoutOldSignature(t *testing.T) {
 
 	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
 	updatedDidDoc.VerificationMethod = []*types.VerificationMethod{aliceDid.VerificationMethod[0]}
-	updatedDidDoc.Authentication = []string{aliceDid.Authentication[0]}
+	updatedDidDoc.Authentication = []*types.VerificationRelationship{aliceDid.Authentication[0]}
 	_, err := setup.SendUpdateDid(updatedDidDoc, MapToListOfSignerKeys(bobKeys))
 
 	// check
 	require.Error(t, err)
-	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), err.Error())
+	require.Equal(t, fmt.Sprintf("there should be at least one signature by %s (old version): signature is required but not found", AliceDID), types.TrimErrorDetails(err.Error()))
 }
 
 func TestDIDDocVerificationMethodDeleted(t *testing.T) {
@@ -118,7 +124,7 @@ func TestDIDDocVerificationMethodDeleted(t *testing.T) {
 	aliceDid := setup.CreateDid(ApubKey, AliceDID)
 	bobDid := setup.CreateDid(BpubKey, BobDID)
 
-	aliceDid.Authentication = append(aliceDid.Authentication, AliceKey2)
+	aliceDid.Authentication = append(aliceDid.Authentication, types.NewVerificationMethodReference(AliceKey2))
 	aliceDid.VerificationMethod = append(aliceDid.VerificationMethod, &types.VerificationMethod{
 		Id:                 AliceKey2,
 		Controller:         BobDID,
@@ -132,7 +138,7 @@ func TestDIDDocVerificationMethodDeleted(t *testing.T) {
 	_, _ = setup.SendCreateDid(aliceDid, aliceKeys)
 
 	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
-	updatedDidDoc.Authentication = []string{aliceDid.Authentication[0]}
+	updatedDidDoc.Authentication = []*types.VerificationRelationship{aliceDid.Authentication[0]}
 	updatedDidDoc.VerificationMethod = []*types.VerificationMethod{aliceDid.VerificationMethod[0]}
 	receivedDid, _ := setup.SendUpdateDid(updatedDidDoc, MapToListOfSignerKeys(ConcatKeys(aliceKeys, bobKeys)))
 
@@ -140,3 +146,126 @@ func TestDIDDocVerificationMethodDeleted(t *testing.T) {
 	require.NotEqual(t, len(aliceDid.VerificationMethod), len(receivedDid.VerificationMethod))
 	require.True(t, reflect.DeepEqual(aliceDid.VerificationMethod[0], receivedDid.VerificationMethod[0]))
 }
+
+func TestKeyAgreementMethodCannotUpdateDid(t *testing.T) {
+	setup := Setup()
+
+	pubKey1, privKey1, _ := ed25519.GenerateKey(rand.Reader)
+	pubKey2, privKey2, _ := ed25519.GenerateKey(rand.Reader)
+
+	aliceDid := setup.CreateDid(pubKey1, AliceDID)
+	aliceDid.KeyAgreement = types.VerificationMethodReferences(AliceKey2)
+	aliceDid.VerificationMethod = append(aliceDid.VerificationMethod, &types.VerificationMethod{
+		Id:                 AliceKey2,
+		Controller:         AliceDID,
+		Type:               Ed25519VerificationKey2020,
+		PublicKeyMultibase: "z" + base58.Encode(pubKey2),
+	})
+
+	_, err := setup.SendCreateDid(aliceDid, map[string]ed25519.PrivateKey{AliceKey1: privKey1})
+	require.NoError(t, err)
+
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.AlsoKnownAs = []string{"https://example.com/alice"}
+
+	_, err = setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey2, key: privKey2}})
+	require.Error(t, err)
+	require.Equal(t, fmt.Sprintf("there should be at least one valid signature by %s (old version): signature is required but not found", AliceDID), types.TrimErrorDetails(err.Error()))
+
+	receivedDid, err := setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey1, key: privKey1}})
+	require.NoError(t, err)
+	require.Equal(t, updatedDidDoc.AlsoKnownAs, receivedDid.AlsoKnownAs)
+}
+
+func TestAuthenticationFallback(t *testing.T) {
+	setup := Setup()
+
+	pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	keys := map[string]ed25519.PrivateKey{AliceKey1: privKey}
+
+	// Authentication authorizes changes of DID Docs without capabilityInvocation
+	aliceDid := setup.CreateDid(pubKey, AliceDID)
+	aliceDid.CapabilityInvocation = nil
+
+	_, err := setup.SendCreateDid(aliceDid, keys)
+	require.NoError(t, err)
+
+	// The fallback is disabled by default
+	params := types.DefaultParams()
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.CapabilityInvocation = types.VerificationMethodReferences(AliceKey1)
+
+	_, err = setup.SendUpdateDid(updatedDidDoc, MapToListOfSignerKeys(keys))
+	require.Error(t, err)
+	require.Equal(t, fmt.Sprintf("there should be at least one valid signature by %s (old version): signature is required but not found", AliceDID), types.TrimErrorDetails(err.Error()))
+
+	params.AuthenticationFallback = true
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	receivedDid, err := setup.SendUpdateDid(updatedDidDoc, MapToListOfSignerKeys(keys))
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationMethodReferences(AliceKey1), receivedDid.CapabilityInvocation)
+}
+
+func TestLegacyDidWithoutRelationships(t *testing.T) {
+	setup := Setup()
+
+	pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	keys := map[string]ed25519.PrivateKey{AliceKey1: privKey}
+
+	// Written before verification relationships were checked
+	aliceDid := setup.CreateDid(pubKey, AliceDID)
+	aliceDid.Authentication = nil
+	aliceDid.AssertionMethod = nil
+	aliceDid.CapabilityInvocation = nil
+	aliceDid.CapabilityDelegation = nil
+	aliceDid.KeyAgreement = nil
+
+	did := aliceDid.ToDid()
+	metadata := types.NewMetadataFromContext(setup.Ctx)
+	require.NoError(t, setup.Keeper.AppendDid(&setup.Ctx, &did, &metadata))
+
+	// Any own verification method authorizes the update regardless of the authentication fallback,
+	// so relationships can be added
+	params := setup.Keeper.GetParams(setup.Ctx)
+	params.AuthenticationFallback = false
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.CapabilityInvocation = types.VerificationMethodReferences(AliceKey1)
+
+	receivedDid, err := setup.SendUpdateDid(updatedDidDoc, MapToListOfSignerKeys(keys))
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationMethodReferences(AliceKey1), receivedDid.CapabilityInvocation)
+}
+
+func TestKeyAgreementMethodCannotSign(t *testing.T) {
+	setup := Setup()
+
+	pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+	keyAgreementKey := "z" + base58.Encode(pubKey)
+
+	// Key agreement methods are stored for DIDComm
+	aliceDid := setup.CreateDid(pubKey, AliceDID)
+	aliceDid.KeyAgreement = types.VerificationMethodReferences(AliceKey2)
+	aliceDid.VerificationMethod = append(aliceDid.VerificationMethod, &types.VerificationMethod{
+		Id:                 AliceKey2,
+		Controller:         AliceDID,
+		Type:               types.X25519KeyAgreementKey2020,
+		PublicKeyMultibase: keyAgreementKey,
+	})
+
+	receivedDid, err := setup.SendCreateDid(aliceDid, map[string]ed25519.PrivateKey{AliceKey1: privKey})
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationMethodReferences(AliceKey2), receivedDid.KeyAgreement)
+
+	// but they are never accepted for signing
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.CapabilityInvocation = types.VerificationMethodReferences(AliceKey2)
+
+	_, err = setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey1, key: privKey}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "capability_invocation: (0: key agreement verification method can be referenced only in key agreement.)")
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDUniqueId(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+
+	uuid, err := utils.GenerateUUID()
+	require.NoError(t, err)
+
+	did := utils.JoinDID(types.DidMethod, "test", uuid)
+	didMsg := setup.CreateDid(keys[AliceKey1].PublicKey, did)
+	signers := map[string]ed25519.PrivateKey{did + "#key-1": keys[AliceKey1].PrivateKey}
+
+	// Only base58 unique ids are allowed by default
+	_, err = setup.SendCreateDid(didMsg, signers)
+	require.ErrorIs(t, err, types.ErrInvalidUniqueId)
+
+	params := types.DefaultParams()
+	params.UniqueIdFormats = []string{types.UniqueIdFormatBase58, types.UniqueIdFormatUUID}
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	receivedDid, err := setup.SendCreateDid(didMsg, signers)
+	require.NoError(t, err)
+	require.Equal(t, did, receivedDid.Id)
+}
+
+func TestSelfCertifyingUniqueId(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+
+	params := types.DefaultParams()
+	params.UniqueIdFormats = []string{types.UniqueIdFormatSelfCertifying}
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	// Ids that aren't derived from a key are rejected
+	_, _, err := setup.InitDid(AliceDID)
+	require.ErrorIs(t, err, types.ErrInvalidUniqueId)
+
+	aliceId := utils.JoinDID(types.DidMethod, "test", utils.SelfCertifyingUniqueId(keys[AliceKey1].PublicKey))
+	bobId := utils.JoinDID(types.DidMethod, "test", utils.SelfCertifyingUniqueId(keys[BobKey1].PublicKey))
+
+	cases := []struct {
+		valid  bool
+		name   string
+		did    string
+		key    ed25519.PrivateKey
+		pubKey ed25519.PublicKey
+	}{
+		{
+			valid:  false,
+			name:   "Not valid: Id is derived from another key",
+			did:    bobId,
+			key:    keys[AliceKey1].PrivateKey,
+			pubKey: keys[AliceKey1].PublicKey,
+		},
+		{
+			valid:  true,
+			name:   "Valid: Id is derived from the signing key",
+			did:    aliceId,
+			key:    keys[AliceKey1].PrivateKey,
+			pubKey: keys[AliceKey1].PublicKey,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			didMsg := setup.CreateDid(tc.pubKey, tc.did)
+			_, err := setup.SendCreateDid(didMsg, map[string]ed25519.PrivateKey{tc.did + "#key-1": tc.key})
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidUniqueId)
+			}
+		})
+	}
+}
+
+func TestSelfCertifyingUniqueIdSquatting(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.UniqueIdFormats = []string{types.UniqueIdFormatSelfCertifying}
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	// Bob claims the id derived from Alice's public key without her signature
+	did := utils.JoinDID(types.DidMethod, "test", utils.SelfCertifyingUniqueId(keys[AliceKey1].PublicKey))
+	didMsg := setup.CreateDid(keys[AliceKey1].PublicKey, did)
+	didMsg.Controller = []string{BobDID}
+	didMsg.VerificationMethod[0].Controller = BobDID
+
+	_, err = setup.SendCreateDid(didMsg, bobKeys)
+	require.ErrorIs(t, err, types.ErrInvalidUniqueId)
+}
+
+func TestSelfCertifyingUniqueIdReusesVerifiedSignatures(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+
+	uniqueId := utils.SelfCertifyingUniqueId(keys[AliceKey1].PublicKey)
+	did := setup.CreateDid(keys[AliceKey1].PublicKey, utils.JoinDID(types.DidMethod, "test", uniqueId)).ToDid()
+	signatures := []*types.SignInfo{{VerificationMethodId: did.Id + "#key-1", Signature: "bm90IGEgc2lnbmF0dXJl"}}
+
+	metadata := types.NewMetadataFromContext(setup.Ctx)
+	stateValue, err := types.NewStateValue(&did, &metadata)
+	require.NoError(t, err)
+	inMemoryDids := map[string]types.StateValue{did.Id: stateValue}
+
+	// The signature has already been verified and charged by the signer check
+	ctx := setup.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	err = keeper.VerifySelfCertifyingUniqueId(&setup.Keeper, &ctx, inMemoryDids, did, uniqueId, []byte("message"), signatures, []string{did.Id + "#key-1"})
+	require.NoError(t, err)
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+
+	// Otherwise it's verified here
+	err = keeper.VerifySelfCertifyingUniqueId(&setup.Keeper, &ctx, inMemoryDids, did, uniqueId, []byte("message"), signatures, nil)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+}
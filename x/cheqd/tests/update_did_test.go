@@ -48,7 +48,8 @@ func TestUpdateDid(t *testing.T) {
 				},
 			},
 			msg: &types.MsgUpdateDidPayload{
-				Id: AliceDID,
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:                 AliceKey1,
@@ -65,8 +66,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: replacing controller and Verification method ID does not work without new sign",
 			signers: []string{AliceKey2, BobKey1, AliceKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{CharlieDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey2),
+				Controller:     []string{CharlieDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey2,
@@ -82,8 +84,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Valid: replacing controller and Verification method ID works with all signatures",
 			signers: []string{AliceKey1, CharlieKey1, AliceKey2},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{CharlieDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey2),
+				Controller:     []string{CharlieDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey2,
@@ -110,7 +113,8 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Valid: Replacing VM controller works with one signature",
 			signers: []string{AliceKey1, BobKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id: AliceDID,
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -125,7 +129,8 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Replacing VM controller does not work without new signature",
 			signers: []string{AliceKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id: AliceDID,
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -141,7 +146,8 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Replacing VM does not work without new signature",
 			signers: []string{AliceKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id: AliceDID,
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey2),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey2,
@@ -157,7 +163,8 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Replacing VM does not work without old signature",
 			signers: []string{AliceKey2},
 			msg: &types.MsgUpdateDidPayload{
-				Id: AliceDID,
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey2),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey2,
@@ -173,7 +180,8 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Replacing VM works with all signatures",
 			signers: []string{AliceKey1, AliceKey2},
 			msg: &types.MsgUpdateDidPayload{
-				Id: AliceDID,
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey2),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey2,
@@ -189,8 +197,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Valid: Adding another verification method",
 			signers: []string{AliceKey1, BobKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{AliceDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1, AliceKey2),
+				Controller:     []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -210,8 +219,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Adding another verification method without new sign",
 			signers: []string{AliceKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{AliceDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1, AliceKey2),
+				Controller:     []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -232,8 +242,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Adding another verification method without old sign",
 			signers: []string{AliceKey2},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{AliceDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1, AliceKey2),
+				Controller:     []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -263,8 +274,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Valid: Replace controller works with all signatures",
 			signers: []string{BobKey1, AliceKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{BobDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
+				Controller:     []string{BobDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -279,8 +291,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Replace controller doesn't work without old signatures",
 			signers: []string{BobKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{BobDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
+				Controller:     []string{BobDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -296,8 +309,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Replace controller doesn't work without new signatures",
 			signers: []string{AliceKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{BobDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
+				Controller:     []string{BobDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -314,8 +328,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Valid: Adding second controller works",
 			signers: []string{AliceKey1, CharlieKey3},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{AliceDID, CharlieDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
+				Controller:     []string{AliceDID, CharlieDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -330,8 +345,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Adding controller without old signature",
 			signers: []string{BobKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{AliceDID, BobDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
+				Controller:     []string{AliceDID, BobDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -347,8 +363,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Not Valid: Add controller without new signature doesn't work",
 			signers: []string{AliceKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{AliceDID, BobDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey1),
+				Controller:     []string{AliceDID, BobDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey1,
@@ -365,8 +382,9 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Valid: Adding verification method with the same controller works",
 			signers: []string{AliceKey1, AliceKey2},
 			msg: &types.MsgUpdateDidPayload{
-				Id:         AliceDID,
-				Controller: []string{AliceDID},
+				Id:             AliceDID,
+				Authentication: types.VerificationMethodReferences(AliceKey2, AliceKey1),
+				Controller:     []string{AliceDID},
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         AliceKey2,
@@ -387,11 +405,11 @@ func TestUpdateDid(t *testing.T) {
 			signers: []string{CharlieKey1},
 			msg: &types.MsgUpdateDidPayload{
 				Id: CharlieDID,
-				Authentication: []string{
+				Authentication: types.VerificationMethodReferences(
 					CharlieKey1,
 					CharlieKey2,
 					CharlieKey3,
-				},
+				),
 
 				VerificationMethod: []*types.VerificationMethod{
 					{
@@ -422,7 +440,8 @@ func TestUpdateDid(t *testing.T) {
 			name:    "Valid: Removing verification method is possible with any kind of valid Bob's key",
 			signers: []string{BobKey1},
 			msg: &types.MsgUpdateDidPayload{
-				Id: BobDID,
+				Id:             BobDID,
+				Authentication: types.VerificationMethodReferences(BobKey1),
 				VerificationMethod: []*types.VerificationMethod{
 					{
 						Id:         BobKey1,
@@ -476,8 +495,24 @@ func TestUpdateDid(t *testing.T) {
 				require.Equal(t, tc.msg.Context, did.Context)
 			} else {
 				require.Error(t, err)
-				require.Equal(t, tc.errMsg, err.Error())
+				require.Equal(t, tc.errMsg, types.TrimErrorDetails(err.Error()))
 			}
 		})
 	}
 }
+
+func TestUpdateDeactivatedDid(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+
+	// DID Docs can be deactivated only in the imported state
+	didMsg := setup.CreateDid(keys[AliceKey1].PublicKey, AliceDID)
+	did := didMsg.ToDid()
+	metadata := types.NewMetadataFromContext(setup.Ctx)
+	metadata.Deactivated = true
+	require.NoError(t, setup.Keeper.AppendDid(&setup.Ctx, &did, &metadata))
+
+	updatedDidDoc := setup.CreateToUpdateDid(didMsg)
+	_, err := setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}})
+	require.ErrorIs(t, err, types.ErrDidDocDeactivated)
+}
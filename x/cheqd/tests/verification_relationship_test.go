@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedCapabilityInvocationMethod(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+
+	// Alice's key is embedded in capabilityInvocation and isn't listed in verificationMethod
+	aliceDid := &types.MsgCreateDidPayload{
+		Id: AliceDID,
+		CapabilityInvocation: []*types.VerificationRelationship{
+			types.NewEmbeddedVerificationMethod(&types.VerificationMethod{
+				Id:                 AliceKey1,
+				Type:               Ed25519VerificationKey2020,
+				Controller:         AliceDID,
+				PublicKeyMultibase: "z" + base58.Encode(keys[AliceKey1].PublicKey),
+			}),
+		},
+		// Methods of other DIDs can be referenced
+		Authentication: types.VerificationMethodReferences(BobKey1),
+	}
+
+	receivedDid, err := setup.SendCreateDid(aliceDid, map[string]ed25519.PrivateKey{AliceKey1: keys[AliceKey1].PrivateKey})
+	require.NoError(t, err)
+	require.Equal(t, aliceDid.CapabilityInvocation, receivedDid.CapabilityInvocation)
+	require.Equal(t, aliceDid.Authentication, receivedDid.Authentication)
+
+	// The embedded method authorizes changes
+	updatedDidDoc := setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.AlsoKnownAs = []string{"https://example.com"}
+
+	receivedDid, err = setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://example.com"}, receivedDid.AlsoKnownAs)
+	require.Equal(t, AliceKey1, receivedDid.CapabilityInvocation[0].GetVerificationMethod().Id)
+
+	// Removing the embedded method requires the signature of its controller
+	updatedDidDoc = setup.CreateToUpdateDid(aliceDid)
+	updatedDidDoc.CapabilityInvocation = nil
+
+	_, err = setup.SendUpdateDid(updatedDidDoc, []SignerKey{{signer: BobKey1, key: keys[BobKey1].PrivateKey}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "there should be at least one signature by "+AliceDID+" (old version)")
+}
+
+func TestMigrateVerificationRelationships(t *testing.T) {
+	setup := Setup()
+
+	_, didMsg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	stateValue, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	expected, err := stateValue.UnpackDataAsDid()
+	require.NoError(t, err)
+
+	// Store the DID Doc as it was encoded with relationships as lists of ids
+	legacyDid := *expected
+	legacyDid.Authentication = nil
+	legacyDid.AssertionMethod = nil
+	legacyDid.CapabilityInvocation = nil
+	legacyDid.CapabilityDelegation = nil
+	legacyDid.KeyAgreement = nil
+	legacyDid.LegacyAuthentication = types.GetVerificationRelationshipIds(didMsg.Authentication)
+	legacyDid.LegacyAssertionMethod = types.GetVerificationRelationshipIds(didMsg.AssertionMethod)
+	legacyDid.LegacyCapabilityInvocation = types.GetVerificationRelationshipIds(didMsg.CapabilityInvocation)
+	legacyDid.LegacyCapabilityDelegation = types.GetVerificationRelationshipIds(didMsg.CapabilityDelegation)
+	legacyDid.LegacyKeyAgreement = types.GetVerificationRelationshipIds(didMsg.KeyAgreement)
+
+	legacyStateValue, err := types.NewStateValue(&legacyDid, stateValue.Metadata)
+	require.NoError(t, err)
+
+	store := prefix.NewStore(setup.Ctx.KVStore(setup.StoreKey), types.KeyPrefix(types.DidKey))
+	store.Set(keeper.GetDidIDBytes(AliceDID), setup.Cdc.MustMarshal(&legacyStateValue))
+
+	// Legacy relationships are read before the migration
+	stateValue, err = setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	read, err := stateValue.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, expected, read)
+
+	require.NoError(t, setup.Keeper.MigrateVerificationRelationships(&setup.Ctx))
+
+	// Legacy fields are no longer stored
+	var migratedStateValue types.StateValue
+	require.NoError(t, migratedStateValue.Unmarshal(store.Get(keeper.GetDidIDBytes(AliceDID))))
+
+	var migrated types.Did
+	require.NoError(t, migrated.Unmarshal(migratedStateValue.Data.Value))
+	require.Equal(t, *expected, migrated)
+}
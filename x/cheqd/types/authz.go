@@ -0,0 +1,71 @@
+package types
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ authz.Authorization = &DidOperationAuthorization{}
+
+// DidOperationMsgTypeURLs returns type URLs of messages that can be authorized with DidOperationAuthorization.
+// It's a function because message names are registered in proto init functions, after package variables.
+func DidOperationMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgCreateDid{}),
+		sdk.MsgTypeURL(&MsgUpdateDid{}),
+	}
+}
+
+func NewDidOperationAuthorization(msgTypeURL string, dids ...string) *DidOperationAuthorization {
+	return &DidOperationAuthorization{
+		Msg:  msgTypeURL,
+		Dids: dids,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL
+func (a DidOperationAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The operation is accepted if its DID is in the list.
+// DID signatures are still verified by the msg server, which also requires the granter to be
+// the signer of operations on DIDs bound to an account.
+func (a DidOperationAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var did string
+
+	switch msg := msg.(type) {
+	case *MsgCreateDid:
+		did = msg.GetPayload().GetId()
+	case *MsgUpdateDid:
+		did = msg.GetPayload().GetId()
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.Dids) > 0 && !utils.Contains(a.Dids, did) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("operation is not allowed for %s", did)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a DidOperationAuthorization) ValidateBasic() error {
+	err := validation.ValidateStruct(&a,
+		validation.Field(&a.Msg, validation.Required, validation.In(utils.ToInterfaces(DidOperationMsgTypeURLs())...)),
+		validation.Field(&a.Dids, IsUniqueStrList(), validation.Each(IsDID(nil))),
+	)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
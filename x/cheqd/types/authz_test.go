@@ -0,0 +1,132 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDidOperationAuthorizationValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		struct_  *DidOperationAuthorization
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "Valid: any DID",
+			struct_: NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgUpdateDid{})),
+			isValid: true,
+		},
+		{
+			name:    "Valid: specific DIDs",
+			struct_: NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgCreateDid{}), "did:cheqd:testnet:123456789abcdefg", "did:cheqd:testnet:gfedcba987654321"),
+			isValid: true,
+		},
+		{
+			name:     "Not valid: not an identity message",
+			struct_:  NewDidOperationAuthorization("/cosmos.bank.v1beta1.MsgSend"),
+			isValid:  false,
+			errorMsg: "msg: must be a valid value.: invalid request",
+		},
+		{
+			name:     "Not valid: DID duplicates",
+			struct_:  NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgUpdateDid{}), "did:cheqd:testnet:123456789abcdefg", "did:cheqd:testnet:123456789abcdefg"),
+			isValid:  false,
+			errorMsg: "dids: there should be no duplicates.: invalid request",
+		},
+		{
+			name:     "Not valid: not a DID",
+			struct_:  NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgUpdateDid{}), "did:cheqd:testnet:123456789abcdefg#key1"),
+			isValid:  false,
+			errorMsg: "dids: (0: unable to split did into method, namespace and id: did method-specific id: invalid character '#' at position 24.).: invalid request",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.struct_.ValidateBasic()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestDidOperationAuthorizationAccept(t *testing.T) {
+	createDid := &MsgCreateDid{Payload: &MsgCreateDidPayload{Id: "did:cheqd:testnet:123456789abcdefg"}}
+	updateDid := &MsgUpdateDid{Payload: &MsgUpdateDidPayload{Id: "did:cheqd:testnet:123456789abcdefg"}}
+
+	cases := []struct {
+		name          string
+		authorization *DidOperationAuthorization
+		msg           sdk.Msg
+		isAccepted    bool
+		errorMsg      string
+	}{
+		{
+			name:          "Valid: any DID",
+			authorization: NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgUpdateDid{})),
+			msg:           updateDid,
+			isAccepted:    true,
+		},
+		{
+			name:          "Valid: DID is in the list",
+			authorization: NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgCreateDid{}), "did:cheqd:testnet:gfedcba987654321", "did:cheqd:testnet:123456789abcdefg"),
+			msg:           createDid,
+			isAccepted:    true,
+		},
+		{
+			name:          "Not valid: DID is not in the list",
+			authorization: NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgUpdateDid{}), "did:cheqd:testnet:gfedcba987654321"),
+			msg:           updateDid,
+			errorMsg:      "operation is not allowed for did:cheqd:testnet:123456789abcdefg: unauthorized",
+		},
+		{
+			name:          "Not valid: another operation",
+			authorization: NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgUpdateDid{})),
+			msg:           createDid,
+			errorMsg:      "type mismatch: invalid type",
+		},
+		{
+			name:          "Not valid: not an identity message",
+			authorization: NewDidOperationAuthorization(sdk.MsgTypeURL(&MsgUpdateDid{})),
+			msg:           &banktypes.MsgSend{},
+			errorMsg:      "type mismatch: invalid type",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := tc.authorization.Accept(sdk.Context{}, tc.msg)
+
+			if tc.isAccepted {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.False(t, resp.Delete)
+			} else {
+				require.EqualError(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestIdentityMsgSigners(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+
+	require.Empty(t, (&MsgCreateDid{}).GetSigners())
+	require.Empty(t, (&MsgUpdateDid{}).GetSigners())
+
+	require.Equal(t, []sdk.AccAddress{signer}, (&MsgCreateDid{Signer: signer.String()}).GetSigners())
+	require.Equal(t, []sdk.AccAddress{signer}, (&MsgUpdateDid{Signer: signer.String()}).GetSigners())
+
+	err := (&MsgUpdateDid{Signer: "not an address"}).ValidateBasic()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "signer:")
+}
@@ -5,16 +5,31 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	// Sdk messages
 	cdc.RegisterConcrete(&MsgCreateDid{}, "cheqd/CreateDid", nil)
 	cdc.RegisterConcrete(&MsgUpdateDid{}, "cheqd/UpdateDid", nil)
+	cdc.RegisterConcrete(&MsgSendResolveDid{}, "cheqd/SendResolveDid", nil)
+
+	// Authorizations
+	cdc.RegisterConcrete(&DidOperationAuthorization{}, "cheqd/DidOperationAuthorization", nil)
+
+	// Fee allowances
+	cdc.RegisterConcrete(&IdentityFeeAllowance{}, "cheqd/IdentityFeeAllowance", nil)
 
 	// State value data
 	cdc.RegisterInterface((*StateValueData)(nil), nil)
 	cdc.RegisterConcrete(&Did{}, "cheqd/Did", nil)
+
+	// Verification relationships. Amino prefixes derived from these names are part of the binary sign bytes
+	// of payloads, so the names must never change.
+	cdc.RegisterInterface((*isVerificationRelationship_Method)(nil), nil)
+	cdc.RegisterConcrete(&VerificationRelationship_VerificationMethodId{}, "cheqd/VerificationMethodId", nil)
+	cdc.RegisterConcrete(&VerificationRelationship_VerificationMethod{}, "cheqd/EmbeddedVerificationMethod", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,8 +37,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDid{},
 		&MsgUpdateDid{},
+		&MsgSendResolveDid{},
 	)
 
+	// Authorizations
+	registry.RegisterImplementations((*authz.Authorization)(nil), &DidOperationAuthorization{})
+
+	// Fee allowances
+	registry.RegisterImplementations((*feegrant.FeeAllowanceI)(nil), &IdentityFeeAllowance{})
+
 	// State value data
 	registry.RegisterInterface("StateValueData", (*StateValueData)(nil))
 	registry.RegisterImplementations((*StateValueData)(nil), &Did{})
@@ -35,3 +57,8 @@ var (
 	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewAminoCodec(amino)
 )
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerificationRelationshipSignBytes(t *testing.T) {
+	payload := MsgCreateDidPayload{
+		Id: ValidTestDID,
+		Authentication: []*VerificationRelationship{
+			NewVerificationMethodReference(ValidTestDID2 + "#key-1"),
+			NewEmbeddedVerificationMethod(&VerificationMethod{
+				Id:                 ValidTestDID + "#key-1",
+				Type:               Ed25519VerificationKey2020,
+				Controller:         ValidTestDID,
+				PublicKeyMultibase: ValidEd25519PubKey,
+			}),
+		},
+	}
+
+	// Binary sign bytes contain the amino prefixes of the registered names: 2616ee51 for cheqd/VerificationMethodId
+	// and fd2a8462 for cheqd/EmbeddedVerificationMethod. Renaming the types invalidates signatures made by clients.
+	expected := "12226469643a63686571643a746573746e65743a313233343536373839616263646566677a300a2e" +
+		"2616ee51" +
+		"0a286469643a63686571643a746573746e65743a67666564636261393837363534333231236b65792d317aa3010aa001" +
+		"fd2a8462" +
+		"0a99010a286469643a63686571643a746573746e65743a31323334353637383961626364656667236b65792d31121a45" +
+		"643235353139566572696669636174696f6e4b6579323032301a226469643a63686571643a746573746e65743a313233" +
+		"343536373839616263646566672a2d7a463168564758584b39726d783548684d5470476e47514a696162397172464a62" +
+		"5158425268536d596a515758"
+
+	require.Equal(t, expected, hex.EncodeToString(payload.GetSignBytes()))
+}
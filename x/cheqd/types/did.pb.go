@@ -24,17 +24,27 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Did struct {
-	Context              []string              `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
-	Id                   string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Controller           []string              `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
-	VerificationMethod   []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
-	Authentication       []string              `protobuf:"bytes,5,rep,name=authentication,proto3" json:"authentication,omitempty"`
-	AssertionMethod      []string              `protobuf:"bytes,6,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
-	CapabilityInvocation []string              `protobuf:"bytes,7,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
-	CapabilityDelegation []string              `protobuf:"bytes,8,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
-	KeyAgreement         []string              `protobuf:"bytes,9,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
-	Service              []*Service            `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
-	AlsoKnownAs          []string              `protobuf:"bytes,11,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	Context            []string              `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
+	Id                 string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Controller         []string              `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
+	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// Verification relationships as lists of verification method ids, encoded this way before v0.6.
+	// Deprecated: read on input and merged into the verification relationships below, never written.
+	LegacyAuthentication       []string                    `protobuf:"bytes,5,rep,name=legacy_authentication,json=legacyAuthentication,proto3" json:"legacy_authentication,omitempty"`                     // Deprecated: Do not use.
+	LegacyAssertionMethod      []string                    `protobuf:"bytes,6,rep,name=legacy_assertion_method,json=legacyAssertionMethod,proto3" json:"legacy_assertion_method,omitempty"`                // Deprecated: Do not use.
+	LegacyCapabilityInvocation []string                    `protobuf:"bytes,7,rep,name=legacy_capability_invocation,json=legacyCapabilityInvocation,proto3" json:"legacy_capability_invocation,omitempty"` // Deprecated: Do not use.
+	LegacyCapabilityDelegation []string                    `protobuf:"bytes,8,rep,name=legacy_capability_delegation,json=legacyCapabilityDelegation,proto3" json:"legacy_capability_delegation,omitempty"` // Deprecated: Do not use.
+	LegacyKeyAgreement         []string                    `protobuf:"bytes,9,rep,name=legacy_key_agreement,json=legacyKeyAgreement,proto3" json:"legacy_key_agreement,omitempty"`                         // Deprecated: Do not use.
+	Service                    []*Service                  `protobuf:"bytes,10,rep,name=service,proto3" json:"service,omitempty"`
+	AlsoKnownAs                []string                    `protobuf:"bytes,11,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	Authentication             []*VerificationRelationship `protobuf:"bytes,12,rep,name=authentication,proto3" json:"authentication,omitempty"`
+	AssertionMethod            []*VerificationRelationship `protobuf:"bytes,13,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	CapabilityInvocation       []*VerificationRelationship `protobuf:"bytes,14,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
+	CapabilityDelegation       []*VerificationRelationship `protobuf:"bytes,15,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
+	KeyAgreement               []*VerificationRelationship `protobuf:"bytes,16,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	// Account the DID is bound to. Optional. If set, DID operations must be submitted with this account
+	// as the signer, so it has to sign the transaction or grant DidOperationAuthorization to the executor.
+	BoundAccount string `protobuf:"bytes,17,opt,name=bound_account,json=boundAccount,proto3" json:"bound_account,omitempty"`
 }
 
 func (m *Did) Reset()         { *m = Did{} }
@@ -98,37 +108,42 @@ func (m *Did) GetVerificationMethod() []*VerificationMethod {
 	return nil
 }
 
-func (m *Did) GetAuthentication() []string {
+// Deprecated: Do not use.
+func (m *Did) GetLegacyAuthentication() []string {
 	if m != nil {
-		return m.Authentication
+		return m.LegacyAuthentication
 	}
 	return nil
 }
 
-func (m *Did) GetAssertionMethod() []string {
+// Deprecated: Do not use.
+func (m *Did) GetLegacyAssertionMethod() []string {
 	if m != nil {
-		return m.AssertionMethod
+		return m.LegacyAssertionMethod
 	}
 	return nil
 }
 
-func (m *Did) GetCapabilityInvocation() []string {
+// Deprecated: Do not use.
+func (m *Did) GetLegacyCapabilityInvocation() []string {
 	if m != nil {
-		return m.CapabilityInvocation
+		return m.LegacyCapabilityInvocation
 	}
 	return nil
 }
 
-func (m *Did) GetCapabilityDelegation() []string {
+// Deprecated: Do not use.
+func (m *Did) GetLegacyCapabilityDelegation() []string {
 	if m != nil {
-		return m.CapabilityDelegation
+		return m.LegacyCapabilityDelegation
 	}
 	return nil
 }
 
-func (m *Did) GetKeyAgreement() []string {
+// Deprecated: Do not use.
+func (m *Did) GetLegacyKeyAgreement() []string {
 	if m != nil {
-		return m.KeyAgreement
+		return m.LegacyKeyAgreement
 	}
 	return nil
 }
@@ -147,6 +162,48 @@ func (m *Did) GetAlsoKnownAs() []string {
 	return nil
 }
 
+func (m *Did) GetAuthentication() []*VerificationRelationship {
+	if m != nil {
+		return m.Authentication
+	}
+	return nil
+}
+
+func (m *Did) GetAssertionMethod() []*VerificationRelationship {
+	if m != nil {
+		return m.AssertionMethod
+	}
+	return nil
+}
+
+func (m *Did) GetCapabilityInvocation() []*VerificationRelationship {
+	if m != nil {
+		return m.CapabilityInvocation
+	}
+	return nil
+}
+
+func (m *Did) GetCapabilityDelegation() []*VerificationRelationship {
+	if m != nil {
+		return m.CapabilityDelegation
+	}
+	return nil
+}
+
+func (m *Did) GetKeyAgreement() []*VerificationRelationship {
+	if m != nil {
+		return m.KeyAgreement
+	}
+	return nil
+}
+
+func (m *Did) GetBoundAccount() string {
+	if m != nil {
+		return m.BoundAccount
+	}
+	return ""
+}
+
 type VerificationMethod struct {
 	Id                 string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type               string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -223,6 +280,93 @@ func (m *VerificationMethod) GetPublicKeyMultibase() string {
 	return ""
 }
 
+// VerificationRelationship is a reference to a verification method, which may belong to another DID,
+// or a verification method embedded in the relationship
+type VerificationRelationship struct {
+	// Types that are valid to be assigned to Method:
+	//	*VerificationRelationship_VerificationMethodId
+	//	*VerificationRelationship_VerificationMethod
+	Method isVerificationRelationship_Method `protobuf_oneof:"method"`
+}
+
+func (m *VerificationRelationship) Reset()         { *m = VerificationRelationship{} }
+func (m *VerificationRelationship) String() string { return proto.CompactTextString(m) }
+func (*VerificationRelationship) ProtoMessage()    {}
+func (*VerificationRelationship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cddf7c2ece8cb, []int{2}
+}
+func (m *VerificationRelationship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationRelationship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationRelationship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationRelationship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationRelationship.Merge(m, src)
+}
+func (m *VerificationRelationship) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationRelationship) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationRelationship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationRelationship proto.InternalMessageInfo
+
+type isVerificationRelationship_Method interface {
+	isVerificationRelationship_Method()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type VerificationRelationship_VerificationMethodId struct {
+	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3,oneof" json:"verification_method_id,omitempty"`
+}
+type VerificationRelationship_VerificationMethod struct {
+	VerificationMethod *VerificationMethod `protobuf:"bytes,2,opt,name=verification_method,json=verificationMethod,proto3,oneof" json:"verification_method,omitempty"`
+}
+
+func (*VerificationRelationship_VerificationMethodId) isVerificationRelationship_Method() {}
+func (*VerificationRelationship_VerificationMethod) isVerificationRelationship_Method()   {}
+
+func (m *VerificationRelationship) GetMethod() isVerificationRelationship_Method {
+	if m != nil {
+		return m.Method
+	}
+	return nil
+}
+
+func (m *VerificationRelationship) GetVerificationMethodId() string {
+	if x, ok := m.GetMethod().(*VerificationRelationship_VerificationMethodId); ok {
+		return x.VerificationMethodId
+	}
+	return ""
+}
+
+func (m *VerificationRelationship) GetVerificationMethod() *VerificationMethod {
+	if x, ok := m.GetMethod().(*VerificationRelationship_VerificationMethod); ok {
+		return x.VerificationMethod
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*VerificationRelationship) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*VerificationRelationship_VerificationMethodId)(nil),
+		(*VerificationRelationship_VerificationMethod)(nil),
+	}
+}
+
 type Service struct {
 	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type            string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -233,7 +377,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb1cddf7c2ece8cb, []int{2}
+	return fileDescriptor_fb1cddf7c2ece8cb, []int{3}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,46 +430,58 @@ func (m *Service) GetServiceEndpoint() string {
 func init() {
 	proto.RegisterType((*Did)(nil), "cheqdid.cheqdnode.cheqd.v1.Did")
 	proto.RegisterType((*VerificationMethod)(nil), "cheqdid.cheqdnode.cheqd.v1.VerificationMethod")
+	proto.RegisterType((*VerificationRelationship)(nil), "cheqdid.cheqdnode.cheqd.v1.VerificationRelationship")
 	proto.RegisterType((*Service)(nil), "cheqdid.cheqdnode.cheqd.v1.Service")
 }
 
 func init() { proto.RegisterFile("cheqd/v1/did.proto", fileDescriptor_fb1cddf7c2ece8cb) }
 
 var fileDescriptor_fb1cddf7c2ece8cb = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x97, 0x76, 0x5b, 0xa9, 0xbb, 0x75, 0x93, 0xd9, 0x24, 0xd3, 0x43, 0x54, 0x75, 0x12,
-	0x6a, 0x0f, 0x24, 0x8c, 0x9d, 0x39, 0x0c, 0xc6, 0x01, 0xaa, 0x21, 0x54, 0xa4, 0x09, 0x71, 0x89,
-	0x9c, 0xf8, 0xad, 0x35, 0x4d, 0xec, 0x92, 0x38, 0xe9, 0xf2, 0x2d, 0xf8, 0x58, 0x1c, 0x77, 0x44,
-	0x9c, 0x50, 0xfb, 0x19, 0xb8, 0xa3, 0x38, 0x6e, 0xa8, 0x5a, 0x31, 0x71, 0x69, 0x9d, 0xdf, 0xfb,
-	0xff, 0x9f, 0xfd, 0xde, 0xb3, 0x11, 0x0e, 0x26, 0xf0, 0x95, 0xb9, 0xd9, 0xb9, 0xcb, 0x38, 0x73,
-	0x66, 0xb1, 0x54, 0x12, 0x77, 0x34, 0xe3, 0xcc, 0xd1, 0xff, 0x42, 0x32, 0x28, 0x57, 0x4e, 0x76,
-	0xde, 0x79, 0x32, 0x96, 0x72, 0x1c, 0x82, 0xab, 0x95, 0x7e, 0x7a, 0xeb, 0x52, 0x91, 0x97, 0xb6,
-	0xce, 0x69, 0x95, 0x2a, 0x90, 0x51, 0x24, 0x45, 0x89, 0x7b, 0xbf, 0xeb, 0xa8, 0x7e, 0xc5, 0x19,
-	0x26, 0xa8, 0x11, 0x48, 0xa1, 0xe0, 0x4e, 0x11, 0xab, 0x5b, 0xef, 0x37, 0x47, 0xab, 0x4f, 0xdc,
-	0x46, 0x35, 0xce, 0x48, 0xad, 0x6b, 0xf5, 0x9b, 0xa3, 0x1a, 0x67, 0xd8, 0x46, 0xa8, 0x08, 0xc5,
-	0x32, 0x0c, 0x21, 0x26, 0x75, 0x2d, 0x5e, 0x23, 0xd8, 0x43, 0x8f, 0x33, 0x88, 0xf9, 0x2d, 0x0f,
-	0xa8, 0xe2, 0x52, 0x78, 0x11, 0xa8, 0x89, 0x64, 0x64, 0xb7, 0x5b, 0xef, 0xb7, 0x5e, 0x38, 0xce,
-	0xbf, 0x4f, 0xef, 0xdc, 0xac, 0xd9, 0xae, 0xb5, 0x6b, 0x84, 0xb3, 0x2d, 0x86, 0x9f, 0xa2, 0x36,
-	0x4d, 0xd5, 0x04, 0x84, 0x32, 0x9c, 0xec, 0xe9, 0x43, 0x6c, 0x50, 0x3c, 0x40, 0xc7, 0x34, 0x49,
-	0x20, 0x5e, 0x3f, 0xc5, 0xbe, 0x56, 0x1e, 0x55, 0xdc, 0xa4, 0xbc, 0x40, 0xa7, 0x01, 0x9d, 0x51,
-	0x9f, 0x87, 0x5c, 0xe5, 0x1e, 0x17, 0x99, 0x34, 0x99, 0x1b, 0x5a, 0x7f, 0xf2, 0x37, 0xf8, 0xb6,
-	0x8a, 0x6d, 0x98, 0x18, 0x84, 0x30, 0x2e, 0x4d, 0x8f, 0x36, 0x4d, 0x57, 0x55, 0x0c, 0x9f, 0xa1,
-	0xc3, 0x29, 0xe4, 0x1e, 0x1d, 0xc7, 0x00, 0x11, 0x08, 0x45, 0x9a, 0x5a, 0x7c, 0x30, 0x85, 0xfc,
-	0x72, 0xc5, 0xf0, 0x4b, 0xd4, 0x48, 0x20, 0xce, 0x78, 0x00, 0x04, 0xe9, 0xb6, 0x9d, 0x3d, 0xd4,
-	0xb6, 0x8f, 0xa5, 0x74, 0xb4, 0xf2, 0xe0, 0x1e, 0x3a, 0xa4, 0x61, 0x22, 0xbd, 0xa9, 0x90, 0x73,
-	0xe1, 0xd1, 0x84, 0xb4, 0xf4, 0x1e, 0xad, 0x02, 0x0e, 0x0b, 0x76, 0x99, 0xf4, 0x7e, 0x5a, 0x08,
-	0x6f, 0xf7, 0xdb, 0x0c, 0xdb, 0xaa, 0x86, 0x8d, 0xd1, 0xae, 0xca, 0x67, 0x60, 0xc6, 0xaf, 0xd7,
-	0x5b, 0x17, 0xc0, 0xda, 0xb8, 0x00, 0xef, 0x51, 0x7b, 0x96, 0xfa, 0x21, 0x0f, 0xbc, 0xa2, 0xd2,
-	0x2f, 0xf3, 0xa9, 0x99, 0x7d, 0xff, 0xa1, 0x22, 0x86, 0x90, 0xdf, 0xd0, 0x30, 0x85, 0x0f, 0x94,
-	0xc7, 0xa3, 0x83, 0xd2, 0x3f, 0x84, 0xfc, 0xdd, 0x7c, 0x8a, 0x9f, 0xa3, 0x93, 0xb5, 0x7c, 0x51,
-	0x1a, 0x2a, 0xee, 0xd3, 0x04, 0xc8, 0x9e, 0xde, 0x19, 0x57, 0xda, 0xeb, 0x55, 0xa4, 0xf7, 0x09,
-	0x35, 0x4c, 0x53, 0xfe, 0xab, 0xa0, 0x01, 0x3a, 0x36, 0xad, 0xf3, 0x40, 0xb0, 0x99, 0xe4, 0x42,
-	0x99, 0xb2, 0x8e, 0x0c, 0x7f, 0x63, 0xf0, 0xab, 0xd7, 0xdf, 0x17, 0xb6, 0x75, 0xbf, 0xb0, 0xad,
-	0x5f, 0x0b, 0xdb, 0xfa, 0xb6, 0xb4, 0x77, 0xee, 0x97, 0xf6, 0xce, 0x8f, 0xa5, 0xbd, 0xf3, 0x79,
-	0x30, 0xe6, 0x6a, 0x92, 0xfa, 0x4e, 0x20, 0x23, 0xb7, 0x7c, 0x6a, 0xfa, 0xf7, 0x59, 0x51, 0xa6,
-	0x7b, 0x67, 0x50, 0xb1, 0x5d, 0xe2, 0xef, 0xeb, 0xa7, 0x77, 0xf1, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xd5, 0x5f, 0x6c, 0xde, 0x03, 0x00, 0x00,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x13, 0x20, 0x70, 0x49, 0x02, 0x6f, 0x5e, 0x78, 0xcf, 0x2f, 0x7a, 0x8a, 0x50, 0xd8,
+	0x84, 0x45, 0x9d, 0xd2, 0xa2, 0x56, 0xaa, 0xd4, 0x45, 0x80, 0x4a, 0xd0, 0x88, 0xaa, 0x72, 0x25,
+	0xd4, 0x56, 0x95, 0xac, 0xb1, 0x3d, 0x24, 0xd3, 0x38, 0x33, 0xa9, 0x3d, 0x0e, 0xf8, 0x2f, 0xfa,
+	0x47, 0xdd, 0x76, 0xc9, 0xb2, 0xea, 0xa6, 0x15, 0xfc, 0x48, 0xe5, 0xf1, 0xd8, 0x32, 0x49, 0x40,
+	0x54, 0x74, 0x13, 0x3b, 0xe7, 0x9e, 0x7b, 0xae, 0xc7, 0x67, 0xe6, 0x18, 0x90, 0x33, 0x20, 0x9f,
+	0xdc, 0xce, 0x64, 0xa7, 0xe3, 0x52, 0xd7, 0x18, 0xfb, 0x5c, 0x70, 0xd4, 0x90, 0x18, 0x75, 0x0d,
+	0x79, 0x65, 0xdc, 0x25, 0xc9, 0x9d, 0x31, 0xd9, 0x69, 0xfc, 0xd7, 0xe7, 0xbc, 0xef, 0x91, 0x8e,
+	0x64, 0xda, 0xe1, 0x69, 0x07, 0xb3, 0x28, 0x69, 0x6b, 0x6c, 0x64, 0x52, 0x0e, 0x1f, 0x8d, 0x38,
+	0x4b, 0xe0, 0xd6, 0x8f, 0x65, 0x28, 0x1d, 0x50, 0x17, 0xe9, 0x50, 0x76, 0x38, 0x13, 0xe4, 0x5c,
+	0xe8, 0xda, 0x66, 0xa9, 0xbd, 0x62, 0xa6, 0x7f, 0x51, 0x0d, 0x8a, 0xd4, 0xd5, 0x8b, 0x9b, 0x5a,
+	0x7b, 0xc5, 0x2c, 0x52, 0x17, 0x35, 0x01, 0xe2, 0x92, 0xcf, 0x3d, 0x8f, 0xf8, 0x7a, 0x49, 0x92,
+	0x73, 0x08, 0xb2, 0xe0, 0xef, 0x09, 0xf1, 0xe9, 0x29, 0x75, 0xb0, 0xa0, 0x9c, 0x59, 0x23, 0x22,
+	0x06, 0xdc, 0xd5, 0x17, 0x36, 0x4b, 0xed, 0xd5, 0x47, 0x86, 0x71, 0xf3, 0xd3, 0x1b, 0x27, 0xb9,
+	0xb6, 0x63, 0xd9, 0x65, 0xa2, 0xc9, 0x0c, 0x86, 0x9e, 0xc2, 0x86, 0x47, 0xfa, 0xd8, 0x89, 0x2c,
+	0x1c, 0x8a, 0x01, 0x61, 0x42, 0x95, 0xf5, 0xc5, 0xf8, 0x59, 0xf6, 0x8a, 0xba, 0x66, 0xd6, 0x13,
+	0x42, 0xf7, 0x5a, 0x1d, 0x3d, 0x83, 0x7f, 0xd3, 0xc6, 0x20, 0x20, 0x7e, 0xfe, 0xe9, 0x96, 0xb2,
+	0x56, 0xa5, 0xdd, 0x4d, 0x19, 0x6a, 0xe8, 0x01, 0xfc, 0xaf, 0x7a, 0x1d, 0x3c, 0xc6, 0x36, 0xf5,
+	0xa8, 0x88, 0x2c, 0xca, 0x26, 0x5c, 0xcd, 0x2e, 0x67, 0x02, 0x8d, 0x84, 0xb7, 0x9f, 0xd1, 0x8e,
+	0x32, 0xd6, 0x7c, 0x15, 0x97, 0xc4, 0x98, 0x54, 0x59, 0xbe, 0x59, 0xe5, 0x20, 0x63, 0xa1, 0x5d,
+	0x50, 0xeb, 0xb3, 0x86, 0x24, 0xb2, 0x70, 0xdf, 0x27, 0x64, 0x44, 0x98, 0xd0, 0x57, 0xb2, 0x6e,
+	0x94, 0xd4, 0x7b, 0x24, 0xea, 0xa6, 0x55, 0xf4, 0x1c, 0xca, 0x01, 0xf1, 0x27, 0xd4, 0x21, 0x3a,
+	0x48, 0x2f, 0xb6, 0x6e, 0xf3, 0xe2, 0x4d, 0x42, 0x35, 0xd3, 0x1e, 0xd4, 0x82, 0x2a, 0xf6, 0x02,
+	0x6e, 0x0d, 0x19, 0x3f, 0x63, 0x16, 0x0e, 0xf4, 0x55, 0xe9, 0xfc, 0x6a, 0x0c, 0xf6, 0x62, 0xac,
+	0x1b, 0xa0, 0x0f, 0x50, 0x9b, 0xb2, 0xa4, 0x22, 0x27, 0xed, 0xde, 0xd5, 0x75, 0x93, 0x78, 0xf2,
+	0x1a, 0x0c, 0xe8, 0xd8, 0x9c, 0xd2, 0x42, 0x16, 0xac, 0xcf, 0xf8, 0x56, 0xbd, 0x87, 0xfe, 0x1a,
+	0x9e, 0xf2, 0x98, 0xc2, 0xc6, 0x7c, 0x73, 0x6b, 0xf7, 0x98, 0x52, 0x77, 0xe6, 0x6d, 0x84, 0xeb,
+	0xa3, 0x72, 0x3b, 0x60, 0xed, 0xcf, 0x8c, 0xca, 0xed, 0x96, 0x77, 0x50, 0xbd, 0xbe, 0x4d, 0xd6,
+	0xef, 0x31, 0xa2, 0x32, 0xcc, 0x6f, 0xa9, 0x2d, 0xa8, 0xda, 0x3c, 0x64, 0xae, 0x85, 0x1d, 0x87,
+	0x87, 0x4c, 0xe8, 0x7f, 0xc9, 0x94, 0xa8, 0x48, 0xb0, 0x9b, 0x60, 0xad, 0xef, 0x1a, 0xa0, 0xd9,
+	0x93, 0xad, 0x62, 0x45, 0xcb, 0x62, 0x05, 0xc1, 0x82, 0x88, 0xc6, 0x44, 0x05, 0x8d, 0xbc, 0x9f,
+	0x89, 0x1a, 0x6d, 0x2a, 0x6a, 0x5e, 0x41, 0x6d, 0x1c, 0xda, 0x1e, 0x75, 0xe4, 0x41, 0xf8, 0x78,
+	0x36, 0x54, 0x29, 0xd3, 0xbe, 0x6d, 0x6d, 0x3d, 0x12, 0x9d, 0x60, 0x2f, 0x24, 0xaf, 0x31, 0xf5,
+	0xcd, 0x4a, 0xd2, 0xdf, 0x23, 0xd1, 0xcb, 0xb3, 0x21, 0x7a, 0x08, 0xf5, 0x9c, 0xde, 0x28, 0xf4,
+	0x04, 0xb5, 0x71, 0x40, 0xf4, 0x45, 0x39, 0x19, 0x65, 0xdc, 0xe3, 0xb4, 0xd2, 0xfa, 0xa2, 0x81,
+	0x7e, 0xd3, 0xcb, 0x42, 0x4f, 0xe0, 0x9f, 0x39, 0x49, 0x68, 0xa5, 0xcb, 0x3e, 0x2c, 0x98, 0xf5,
+	0xd9, 0x78, 0x3b, 0x72, 0x11, 0x9e, 0x9f, 0xa0, 0xf1, 0x9b, 0xf9, 0xed, 0x04, 0x3d, 0x2c, 0xcc,
+	0xcb, 0xd0, 0xbd, 0x65, 0x58, 0x4a, 0x54, 0x5b, 0x6f, 0xa1, 0xac, 0xce, 0xfa, 0x9d, 0x2c, 0xd9,
+	0x86, 0x75, 0x95, 0x08, 0x16, 0x61, 0xee, 0x98, 0x53, 0x26, 0x94, 0x31, 0x6b, 0x0a, 0x7f, 0xa1,
+	0xe0, 0xbd, 0xfd, 0xaf, 0x97, 0x4d, 0xed, 0xe2, 0xb2, 0xa9, 0xfd, 0xbc, 0x6c, 0x6a, 0x9f, 0xaf,
+	0x9a, 0x85, 0x8b, 0xab, 0x66, 0xe1, 0xdb, 0x55, 0xb3, 0xf0, 0x7e, 0xbb, 0x4f, 0xc5, 0x20, 0xb4,
+	0x0d, 0x87, 0x8f, 0x3a, 0xc9, 0x67, 0x49, 0xfe, 0x3e, 0x88, 0x17, 0xd3, 0x39, 0x57, 0x50, 0x3c,
+	0x2e, 0xb0, 0x97, 0xe4, 0x67, 0xea, 0xf1, 0xaf, 0x01, 0x00, 0x9b, 0x16, 0x75, 0x6c, 0x0a, 0x07,
+	0x00, 0x00,
 }
 
 func (m *Did) Marshal() (dAtA []byte, err error) {
@@ -348,6 +504,87 @@ func (m *Did) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BoundAccount) > 0 {
+		i -= len(m.BoundAccount)
+		copy(dAtA[i:], m.BoundAccount)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.BoundAccount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.KeyAgreement) > 0 {
+		for iNdEx := len(m.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAgreement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapabilityDelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for iNdEx := len(m.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapabilityInvocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for iNdEx := len(m.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssertionMethod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Authentication) > 0 {
+		for iNdEx := len(m.Authentication) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authentication[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.AlsoKnownAs) > 0 {
 		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AlsoKnownAs[iNdEx])
@@ -371,47 +608,47 @@ func (m *Did) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x52
 		}
 	}
-	if len(m.KeyAgreement) > 0 {
-		for iNdEx := len(m.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeyAgreement[iNdEx])
-			copy(dAtA[i:], m.KeyAgreement[iNdEx])
-			i = encodeVarintDid(dAtA, i, uint64(len(m.KeyAgreement[iNdEx])))
+	if len(m.LegacyKeyAgreement) > 0 {
+		for iNdEx := len(m.LegacyKeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyKeyAgreement[iNdEx])
+			copy(dAtA[i:], m.LegacyKeyAgreement[iNdEx])
+			i = encodeVarintDid(dAtA, i, uint64(len(m.LegacyKeyAgreement[iNdEx])))
 			i--
 			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.CapabilityDelegation) > 0 {
-		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CapabilityDelegation[iNdEx])
-			copy(dAtA[i:], m.CapabilityDelegation[iNdEx])
-			i = encodeVarintDid(dAtA, i, uint64(len(m.CapabilityDelegation[iNdEx])))
+	if len(m.LegacyCapabilityDelegation) > 0 {
+		for iNdEx := len(m.LegacyCapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyCapabilityDelegation[iNdEx])
+			copy(dAtA[i:], m.LegacyCapabilityDelegation[iNdEx])
+			i = encodeVarintDid(dAtA, i, uint64(len(m.LegacyCapabilityDelegation[iNdEx])))
 			i--
 			dAtA[i] = 0x42
 		}
 	}
-	if len(m.CapabilityInvocation) > 0 {
-		for iNdEx := len(m.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CapabilityInvocation[iNdEx])
-			copy(dAtA[i:], m.CapabilityInvocation[iNdEx])
-			i = encodeVarintDid(dAtA, i, uint64(len(m.CapabilityInvocation[iNdEx])))
+	if len(m.LegacyCapabilityInvocation) > 0 {
+		for iNdEx := len(m.LegacyCapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyCapabilityInvocation[iNdEx])
+			copy(dAtA[i:], m.LegacyCapabilityInvocation[iNdEx])
+			i = encodeVarintDid(dAtA, i, uint64(len(m.LegacyCapabilityInvocation[iNdEx])))
 			i--
 			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.AssertionMethod) > 0 {
-		for iNdEx := len(m.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AssertionMethod[iNdEx])
-			copy(dAtA[i:], m.AssertionMethod[iNdEx])
-			i = encodeVarintDid(dAtA, i, uint64(len(m.AssertionMethod[iNdEx])))
+	if len(m.LegacyAssertionMethod) > 0 {
+		for iNdEx := len(m.LegacyAssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyAssertionMethod[iNdEx])
+			copy(dAtA[i:], m.LegacyAssertionMethod[iNdEx])
+			i = encodeVarintDid(dAtA, i, uint64(len(m.LegacyAssertionMethod[iNdEx])))
 			i--
 			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Authentication) > 0 {
-		for iNdEx := len(m.Authentication) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Authentication[iNdEx])
-			copy(dAtA[i:], m.Authentication[iNdEx])
-			i = encodeVarintDid(dAtA, i, uint64(len(m.Authentication[iNdEx])))
+	if len(m.LegacyAuthentication) > 0 {
+		for iNdEx := len(m.LegacyAuthentication) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyAuthentication[iNdEx])
+			copy(dAtA[i:], m.LegacyAuthentication[iNdEx])
+			i = encodeVarintDid(dAtA, i, uint64(len(m.LegacyAuthentication[iNdEx])))
 			i--
 			dAtA[i] = 0x2a
 		}
@@ -523,6 +760,73 @@ func (m *VerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VerificationRelationship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationRelationship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationRelationship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Method != nil {
+		{
+			size := m.Method.Size()
+			i -= size
+			if _, err := m.Method.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationRelationship_VerificationMethodId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationRelationship_VerificationMethodId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.VerificationMethodId)
+	copy(dAtA[i:], m.VerificationMethodId)
+	i = encodeVarintDid(dAtA, i, uint64(len(m.VerificationMethodId)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *VerificationRelationship_VerificationMethod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationRelationship_VerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VerificationMethod != nil {
+		{
+			size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDid(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Service) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -606,32 +910,32 @@ func (m *Did) Size() (n int) {
 			n += 1 + l + sovDid(uint64(l))
 		}
 	}
-	if len(m.Authentication) > 0 {
-		for _, s := range m.Authentication {
+	if len(m.LegacyAuthentication) > 0 {
+		for _, s := range m.LegacyAuthentication {
 			l = len(s)
 			n += 1 + l + sovDid(uint64(l))
 		}
 	}
-	if len(m.AssertionMethod) > 0 {
-		for _, s := range m.AssertionMethod {
+	if len(m.LegacyAssertionMethod) > 0 {
+		for _, s := range m.LegacyAssertionMethod {
 			l = len(s)
 			n += 1 + l + sovDid(uint64(l))
 		}
 	}
-	if len(m.CapabilityInvocation) > 0 {
-		for _, s := range m.CapabilityInvocation {
+	if len(m.LegacyCapabilityInvocation) > 0 {
+		for _, s := range m.LegacyCapabilityInvocation {
 			l = len(s)
 			n += 1 + l + sovDid(uint64(l))
 		}
 	}
-	if len(m.CapabilityDelegation) > 0 {
-		for _, s := range m.CapabilityDelegation {
+	if len(m.LegacyCapabilityDelegation) > 0 {
+		for _, s := range m.LegacyCapabilityDelegation {
 			l = len(s)
 			n += 1 + l + sovDid(uint64(l))
 		}
 	}
-	if len(m.KeyAgreement) > 0 {
-		for _, s := range m.KeyAgreement {
+	if len(m.LegacyKeyAgreement) > 0 {
+		for _, s := range m.LegacyKeyAgreement {
 			l = len(s)
 			n += 1 + l + sovDid(uint64(l))
 		}
@@ -648,6 +952,40 @@ func (m *Did) Size() (n int) {
 			n += 1 + l + sovDid(uint64(l))
 		}
 	}
+	if len(m.Authentication) > 0 {
+		for _, e := range m.Authentication {
+			l = e.Size()
+			n += 1 + l + sovDid(uint64(l))
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for _, e := range m.AssertionMethod {
+			l = e.Size()
+			n += 1 + l + sovDid(uint64(l))
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for _, e := range m.CapabilityInvocation {
+			l = e.Size()
+			n += 1 + l + sovDid(uint64(l))
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for _, e := range m.CapabilityDelegation {
+			l = e.Size()
+			n += 1 + l + sovDid(uint64(l))
+		}
+	}
+	if len(m.KeyAgreement) > 0 {
+		for _, e := range m.KeyAgreement {
+			l = e.Size()
+			n += 2 + l + sovDid(uint64(l))
+		}
+	}
+	l = len(m.BoundAccount)
+	if l > 0 {
+		n += 2 + l + sovDid(uint64(l))
+	}
 	return n
 }
 
@@ -682,6 +1020,40 @@ func (m *VerificationMethod) Size() (n int) {
 	return n
 }
 
+func (m *VerificationRelationship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Method != nil {
+		n += m.Method.Size()
+	}
+	return n
+}
+
+func (m *VerificationRelationship_VerificationMethodId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationMethodId)
+	n += 1 + l + sovDid(uint64(l))
+	return n
+}
+func (m *VerificationRelationship_VerificationMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VerificationMethod != nil {
+		l = m.VerificationMethod.Size()
+		n += 1 + l + sovDid(uint64(l))
+	}
+	return n
+}
 func (m *Service) Size() (n int) {
 	if m == nil {
 		return 0
@@ -719,28 +1091,222 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowDid
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Did: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Did: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = append(m.Controller, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethod = append(m.VerificationMethod, &VerificationMethod{})
+			if err := m.VerificationMethod[len(m.VerificationMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAuthentication", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LegacyAuthentication = append(m.LegacyAuthentication, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAssertionMethod", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Did: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Did: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyAssertionMethod = append(m.LegacyAssertionMethod, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyCapabilityInvocation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -768,11 +1334,11 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			m.LegacyCapabilityInvocation = append(m.LegacyCapabilityInvocation, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyCapabilityDelegation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -800,11 +1366,11 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.LegacyCapabilityDelegation = append(m.LegacyCapabilityDelegation, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyKeyAgreement", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -832,11 +1398,11 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = append(m.Controller, string(dAtA[iNdEx:postIndex]))
+			m.LegacyKeyAgreement = append(m.LegacyKeyAgreement, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -863,14 +1429,14 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationMethod = append(m.VerificationMethod, &VerificationMethod{})
-			if err := m.VerificationMethod[len(m.VerificationMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Service = append(m.Service, &Service{})
+			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -898,13 +1464,13 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authentication = append(m.Authentication, string(dAtA[iNdEx:postIndex]))
+			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDid
@@ -914,29 +1480,31 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssertionMethod = append(m.AssertionMethod, string(dAtA[iNdEx:postIndex]))
+			m.Authentication = append(m.Authentication, &VerificationRelationship{})
+			if err := m.Authentication[len(m.Authentication)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDid
@@ -946,29 +1514,31 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityInvocation = append(m.CapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+			m.AssertionMethod = append(m.AssertionMethod, &VerificationRelationship{})
+			if err := m.AssertionMethod[len(m.AssertionMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDid
@@ -978,29 +1548,31 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityDelegation = append(m.CapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+			m.CapabilityInvocation = append(m.CapabilityInvocation, &VerificationRelationship{})
+			if err := m.CapabilityInvocation[len(m.CapabilityInvocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDid
@@ -1010,27 +1582,29 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyAgreement = append(m.KeyAgreement, string(dAtA[iNdEx:postIndex]))
+			m.CapabilityDelegation = append(m.CapabilityDelegation, &VerificationRelationship{})
+			if err := m.CapabilityDelegation[len(m.CapabilityDelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1057,14 +1631,14 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = append(m.Service, &Service{})
-			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyAgreement = append(m.KeyAgreement, &VerificationRelationship{})
+			if err := m.KeyAgreement[len(m.KeyAgreement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1092,7 +1666,7 @@ func (m *Did) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
+			m.BoundAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1327,6 +1901,123 @@ func (m *VerificationMethod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VerificationRelationship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationRelationship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationRelationship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = &VerificationRelationship_VerificationMethodId{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VerificationMethod{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Method = &VerificationRelationship_VerificationMethod{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Service) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+)
+
+// DidCoreContext must be the first context of JSON-LD representations of DID Docs
+const DidCoreContext = "https://www.w3.org/ns/did/v1"
+
+// VerificationMethodTypeContexts are JSON-LD contexts defining verification method types
+var VerificationMethodTypeContexts = map[string]string{
+	Ed25519VerificationKey2020:        "https://w3id.org/security/suites/ed25519-2020/v1",
+	EcdsaSecp256k1VerificationKey2019: "https://w3id.org/security/suites/secp256k1-2019/v1",
+	JsonWebKey2020:                    "https://w3id.org/security/suites/jws-2020/v1",
+	X25519KeyAgreementKey2020:         "https://w3id.org/security/suites/x25519-2020/v1",
+	X25519KeyAgreementKey2019:         "https://w3id.org/security/suites/x25519-2019/v1",
+}
+
+// Helpers
+
+// GetJSONLDContext returns @context of the JSON-LD representation of the DID Doc: the DID Core context,
+// contexts listed in the DID Doc and contexts of the verification method types in use.
+func (did *Did) GetJSONLDContext() []string {
+	result := []string{DidCoreContext}
+
+	for _, context := range did.Context {
+		if !utils.Contains(result, context) {
+			result = append(result, context)
+		}
+	}
+
+	for _, vm := range did.GetAllVerificationMethods() {
+		context, found := VerificationMethodTypeContexts[vm.Type]
+		if found && !utils.Contains(result, context) {
+			result = append(result, context)
+		}
+	}
+
+	return result
+}
@@ -8,8 +8,9 @@ import (
 var _ StateValueData = &Did{}
 
 func NewDid(context []string, id string, controller []string, verificationMethod []*VerificationMethod,
-	authentication []string, assertionMethod []string, capabilityInvocation []string, capabilityDelegation []string,
-	keyAgreement []string, service []*Service, alsoKnownAs []string,
+	authentication []*VerificationRelationship, assertionMethod []*VerificationRelationship,
+	capabilityInvocation []*VerificationRelationship, capabilityDelegation []*VerificationRelationship,
+	keyAgreement []*VerificationRelationship, service []*Service, alsoKnownAs []string,
 ) *Did {
 	return &Did{
 		Context:              context,
@@ -46,7 +47,7 @@ func (did *Did) ReplaceIds(old, new string) {
 		did.Id = new
 	}
 
-	for _, vm := range did.VerificationMethod {
+	for _, vm := range did.GetAllVerificationMethods() {
 		// Controller
 		if vm.Controller == old {
 			vm.Controller = new
@@ -60,6 +61,38 @@ func (did *Did) ReplaceIds(old, new string) {
 
 		vm.Id = utils.JoinDIDUrl(did, path, query, fragment)
 	}
+
+	// Verification relationships, embedded methods are handled above
+	for _, relationship := range did.GetVerificationRelationships() {
+		for _, vr := range relationship {
+			reference, ok := vr.Method.(*VerificationRelationship_VerificationMethodId)
+			if !ok {
+				continue
+			}
+
+			did, path, query, fragment := utils.MustSplitDIDUrl(reference.VerificationMethodId)
+			if did == old {
+				reference.VerificationMethodId = utils.JoinDIDUrl(new, path, query, fragment)
+			}
+		}
+	}
+}
+
+// GetVerificationRelationships returns all verification relationships of the DID Doc
+func (did *Did) GetVerificationRelationships() [][]*VerificationRelationship {
+	return [][]*VerificationRelationship{did.Authentication, did.AssertionMethod, did.CapabilityInvocation, did.CapabilityDelegation, did.KeyAgreement}
+}
+
+// GetAllVerificationMethods returns verification methods listed in did.verification_method
+// followed by verification methods embedded in verification relationships
+func (did *Did) GetAllVerificationMethods() []*VerificationMethod {
+	result := append([]*VerificationMethod{}, did.VerificationMethod...)
+
+	for _, relationship := range did.GetVerificationRelationships() {
+		result = append(result, GetEmbeddedVerificationMethods(relationship)...)
+	}
+
+	return result
 }
 
 func (did *Did) GetControllersOrSubject() []string {
@@ -72,10 +105,39 @@ func (did *Did) GetControllersOrSubject() []string {
 	return result
 }
 
+// GetCapabilityInvocationMethods returns ids of verification methods authorized to change DID Docs on behalf of the DID.
+// Legacy DID Docs without any verification relationships are authorized by any of their verification methods,
+// as they were before relationships were checked. With authentication fallback, authentication is used
+// if capabilityInvocation is empty.
+func (did *Did) GetCapabilityInvocationMethods(authenticationFallback bool) []string {
+	if len(did.CapabilityInvocation) == 0 {
+		if !did.HasVerificationRelationships() {
+			var result []string
+			for _, vm := range did.VerificationMethod {
+				result = append(result, vm.Id)
+			}
+
+			return result
+		}
+
+		if authenticationFallback {
+			return GetVerificationRelationshipIds(did.Authentication)
+		}
+	}
+
+	return GetVerificationRelationshipIds(did.CapabilityInvocation)
+}
+
+// HasVerificationRelationships checks that at least one verification relationship is set
+func (did *Did) HasVerificationRelationships() bool {
+	return len(did.Authentication) > 0 || len(did.AssertionMethod) > 0 || len(did.CapabilityInvocation) > 0 ||
+		len(did.CapabilityDelegation) > 0 || len(did.KeyAgreement) > 0
+}
+
 func (did *Did) GetVerificationMethodControllers() []string {
 	var result []string
 
-	for _, vm := range did.VerificationMethod {
+	for _, vm := range did.GetAllVerificationMethods() {
 		result = append(result, vm.Controller)
 	}
 
@@ -85,30 +147,38 @@ func (did *Did) GetVerificationMethodControllers() []string {
 // Validation
 
 func (did Did) Validate(allowedNamespaces []string) error {
+	allVerificationMethods := did.GetAllVerificationMethods()
+
 	return validation.ValidateStruct(&did,
+		validation.Field(&did.Context, IsUniqueStrList(), validation.Each(IsAbsoluteURL())),
 		validation.Field(&did.Id, validation.Required, IsDID(allowedNamespaces)),
 		validation.Field(&did.Controller, IsUniqueStrList(), validation.Each(IsDID(allowedNamespaces))),
 		validation.Field(&did.VerificationMethod,
-			IsUniqueVerificationMethodListByIdRule(), validation.Each(ValidVerificationMethodRule(did.Id, allowedNamespaces)),
+			// Ids of embedded verification methods must be unique across the document as well
+			validation.By(func(interface{}) error {
+				return IsUniqueVerificationMethodListByIdRule().Validate(allVerificationMethods)
+			}),
+			validation.Each(ValidVerificationMethodRule(did.Id, allowedNamespaces)),
 		),
 
 		validation.Field(&did.Authentication,
-			IsUniqueStrList(), validation.Each(IsDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(did.Id)),
+			IsUniqueVerificationRelationshipListByIdRule(), validation.Each(ValidVerificationRelationshipRule(did.Id, allowedNamespaces), IsNotKeyAgreementMethodRule(allVerificationMethods)),
 		),
 		validation.Field(&did.AssertionMethod,
-			IsUniqueStrList(), validation.Each(IsDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(did.Id)),
+			IsUniqueVerificationRelationshipListByIdRule(), validation.Each(ValidVerificationRelationshipRule(did.Id, allowedNamespaces), IsNotKeyAgreementMethodRule(allVerificationMethods)),
 		),
 		validation.Field(&did.CapabilityInvocation,
-			IsUniqueStrList(), validation.Each(IsDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(did.Id)),
+			IsUniqueVerificationRelationshipListByIdRule(), validation.Each(ValidVerificationRelationshipRule(did.Id, allowedNamespaces), IsNotKeyAgreementMethodRule(allVerificationMethods), IsOwnVerificationMethodRule(did.Id)),
 		),
 		validation.Field(&did.CapabilityDelegation,
-			IsUniqueStrList(), validation.Each(IsDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(did.Id)),
+			IsUniqueVerificationRelationshipListByIdRule(), validation.Each(ValidVerificationRelationshipRule(did.Id, allowedNamespaces), IsNotKeyAgreementMethodRule(allVerificationMethods)),
 		),
 		validation.Field(&did.KeyAgreement,
-			IsUniqueStrList(), validation.Each(IsDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(did.Id)),
+			IsUniqueVerificationRelationshipListByIdRule(), validation.Each(ValidVerificationRelationshipRule(did.Id, allowedNamespaces)),
 		),
 
 		validation.Field(&did.Service, IsUniqueServiceListByIdRule(), validation.Each(ValidServiceRule(did.Id, allowedNamespaces))),
 		validation.Field(&did.AlsoKnownAs, IsUniqueStrList(), validation.Each(IsURI())),
+		validation.Field(&did.BoundAccount, validation.When(did.BoundAccount != "", IsAccAddress())),
 	)
 }
@@ -55,7 +55,7 @@ func TestDidValidation(t *testing.T) {
 				},
 			},
 			isValid:  false,
-			errorMsg: "id: unable to split did into method, namespace and id; verification_method: (0: (id: must have prefix: badDid.).).",
+			errorMsg: "id: unable to split did into method, namespace and id: did must start with \"did:\"; verification_method: (0: (id: must have prefix: badDid.).).",
 		},
 		{
 			name: "Valid: Verification Method: all is fine with type Ed25519VerificationKey2020",
@@ -106,7 +106,7 @@ func TestDidValidation(t *testing.T) {
 				},
 			},
 			isValid:  false,
-			errorMsg: "verification_method: (0: (id: unable to split did into method, namespace and id.).).",
+			errorMsg: "verification_method: (0: (id: unable to split did url into did, path, query and fragment: did must start with \"did:\".).).",
 		},
 		{
 			name: "Not valid: Verification Method: Wrong controller",
@@ -123,7 +123,7 @@ func TestDidValidation(t *testing.T) {
 				},
 			},
 			isValid:  false,
-			errorMsg: "verification_method: (0: (controller: unable to split did into method, namespace and id.).).",
+			errorMsg: "verification_method: (0: (controller: unable to split did into method, namespace and id: did must start with \"did:\".).).",
 		},
 		{
 			name: "Valid: Controller: List of DIDs allowed",
@@ -158,7 +158,7 @@ func TestDidValidation(t *testing.T) {
 				},
 			},
 			isValid:  false,
-			errorMsg: "controller: (1: unable to split did into method, namespace and id.).",
+			errorMsg: "controller: (1: unable to split did into method, namespace and id: did must start with \"did:\".).",
 		},
 		{
 			name: "Allowed namespaces: Negative",
@@ -217,6 +217,172 @@ func TestDidValidation(t *testing.T) {
 			isValid:  false,
 			errorMsg: "verification_method: there are verification method duplicates.",
 		},
+		{
+			name: "Valid: key agreement method in key agreement",
+			struct_: &Did{
+				Id: ValidTestDID,
+				VerificationMethod: []*VerificationMethod{
+					{
+						Id:                 fmt.Sprintf("%s#key-1", ValidTestDID),
+						Type:               "X25519KeyAgreementKey2020",
+						Controller:         ValidTestDID,
+						PublicKeyMultibase: ValidX25519PubKey,
+					},
+				},
+				KeyAgreement: VerificationMethodReferences(fmt.Sprintf("%s#key-1", ValidTestDID)),
+			},
+			isValid: true,
+		},
+		{
+			name: "Not valid: key agreement method in capability invocation",
+			struct_: &Did{
+				Id: ValidTestDID,
+				VerificationMethod: []*VerificationMethod{
+					{
+						Id:           fmt.Sprintf("%s#key-1", ValidTestDID),
+						Type:         "JsonWebKey2020",
+						Controller:   ValidTestDID,
+						PublicKeyJwk: ValidX25519PubKeyJWK,
+					},
+				},
+				Authentication:       VerificationMethodReferences(fmt.Sprintf("%s#key-1", ValidTestDID)),
+				CapabilityInvocation: VerificationMethodReferences(fmt.Sprintf("%s#key-1", ValidTestDID)),
+			},
+			isValid:  false,
+			errorMsg: "authentication: (0: key agreement verification method can be referenced only in key agreement.); capability_invocation: (0: key agreement verification method can be referenced only in key agreement.).",
+		},
+		{
+			name: "Valid: Context: absolute URLs",
+			struct_: &Did{
+				Id:      ValidTestDID,
+				Context: []string{DidCoreContext, "https://w3id.org/security/suites/ed25519-2020/v1"},
+			},
+			isValid: true,
+		},
+		{
+			name: "Not valid: Context: relative URL",
+			struct_: &Did{
+				Id:      ValidTestDID,
+				Context: []string{"Context"},
+			},
+			isValid:  false,
+			errorMsg: "context: (0: URL: Context is not valid: scheme is required.).",
+		},
+		{
+			name: "Not valid: Context: duplicates",
+			struct_: &Did{
+				Id:      ValidTestDID,
+				Context: []string{DidCoreContext, DidCoreContext},
+			},
+			isValid:  false,
+			errorMsg: "context: there should be no duplicates.",
+		},
+		{
+			name: "Valid: Verification Relationships: embedded method and reference to another DID",
+			struct_: &Did{
+				Id: ValidTestDID,
+				Authentication: []*VerificationRelationship{
+					NewEmbeddedVerificationMethod(&VerificationMethod{
+						Id:                 fmt.Sprintf("%s#key-1", ValidTestDID),
+						Type:               "Ed25519VerificationKey2020",
+						Controller:         ValidTestDID,
+						PublicKeyMultibase: ValidEd25519PubKey,
+					}),
+					NewVerificationMethodReference(fmt.Sprintf("%s#key-1", ValidTestDID2)),
+				},
+			},
+			isValid: true,
+		},
+		{
+			name: "Not valid: Verification Relationships: capability invocation by a method of another DID",
+			struct_: &Did{
+				Id:                   ValidTestDID,
+				CapabilityInvocation: VerificationMethodReferences(fmt.Sprintf("%s#key-1", ValidTestDID2)),
+			},
+			isValid:  false,
+			errorMsg: "capability_invocation: (0: verification method of another DID can't be used for capability invocation: " + ValidTestDID2 + ".).",
+		},
+		{
+			name: "Not valid: Verification Relationships: embedded method of another DID",
+			struct_: &Did{
+				Id: ValidTestDID,
+				Authentication: []*VerificationRelationship{
+					NewEmbeddedVerificationMethod(&VerificationMethod{
+						Id:                 fmt.Sprintf("%s#key-1", ValidTestDID2),
+						Type:               "Ed25519VerificationKey2020",
+						Controller:         ValidTestDID2,
+						PublicKeyMultibase: ValidEd25519PubKey,
+					}),
+				},
+			},
+			isValid:  false,
+			errorMsg: "authentication: (0: (id: must have prefix: did:cheqd:testnet:123456789abcdefg.).).",
+		},
+		{
+			name: "Not valid: Verification Relationships: invalid embedded method",
+			struct_: &Did{
+				Id: ValidTestDID,
+				Authentication: []*VerificationRelationship{
+					NewEmbeddedVerificationMethod(&VerificationMethod{
+						Id:                 fmt.Sprintf("%s#key-1", ValidTestDID),
+						Type:               "Ed25519VerificationKey2020",
+						Controller:         ValidTestDID,
+						PublicKeyMultibase: NotValidEd25519PubKey,
+					}),
+				},
+			},
+			isValid:  false,
+			errorMsg: "authentication: (0: (public_key_multibase: ed25519: bad public key length: 18.).).",
+		},
+		{
+			name: "Not valid: Verification Relationships: empty relationship",
+			struct_: &Did{
+				Id:             ValidTestDID,
+				Authentication: []*VerificationRelationship{{}},
+			},
+			isValid:  false,
+			errorMsg: "authentication: (0: either verification method id or embedded verification method is required.).",
+		},
+		{
+			name: "Not valid: Verification Relationships: embedded method id duplicates listed method",
+			struct_: &Did{
+				Id: ValidTestDID,
+				VerificationMethod: []*VerificationMethod{
+					{
+						Id:                 fmt.Sprintf("%s#key-1", ValidTestDID),
+						Type:               "Ed25519VerificationKey2020",
+						Controller:         ValidTestDID,
+						PublicKeyMultibase: ValidEd25519PubKey,
+					},
+				},
+				CapabilityInvocation: []*VerificationRelationship{
+					NewEmbeddedVerificationMethod(&VerificationMethod{
+						Id:                 fmt.Sprintf("%s#key-1", ValidTestDID),
+						Type:               "Ed25519VerificationKey2020",
+						Controller:         ValidTestDID,
+						PublicKeyMultibase: ValidEd25519PubKey,
+					}),
+				},
+			},
+			isValid:  false,
+			errorMsg: "verification_method: there are verification method duplicates.",
+		},
+		{
+			name: "Not valid: Verification Relationships: embedded key agreement method in authentication",
+			struct_: &Did{
+				Id: ValidTestDID,
+				Authentication: []*VerificationRelationship{
+					NewEmbeddedVerificationMethod(&VerificationMethod{
+						Id:                 fmt.Sprintf("%s#key-1", ValidTestDID),
+						Type:               "X25519KeyAgreementKey2020",
+						Controller:         ValidTestDID,
+						PublicKeyMultibase: ValidX25519PubKey,
+					}),
+				},
+			},
+			isValid:  false,
+			errorMsg: "authentication: (0: key agreement verification method can be referenced only in key agreement.).",
+		},
 	}
 
 	for _, tc := range cases {
@@ -232,3 +398,94 @@ func TestDidValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCapabilityInvocationMethods(t *testing.T) {
+	key1 := ValidTestDID + "#key-1"
+	key2 := ValidTestDID + "#key-2"
+
+	did := Did{Id: ValidTestDID, Authentication: VerificationMethodReferences(key1)}
+	require.Equal(t, []string{key1}, did.GetCapabilityInvocationMethods(true))
+	require.Empty(t, did.GetCapabilityInvocationMethods(false))
+
+	did.CapabilityInvocation = VerificationMethodReferences(key2)
+	require.Equal(t, []string{key2}, did.GetCapabilityInvocationMethods(true))
+	require.Equal(t, []string{key2}, did.GetCapabilityInvocationMethods(false))
+
+	// Legacy DID Doc without relationships
+	legacyDid := Did{
+		Id: ValidTestDID,
+		VerificationMethod: []*VerificationMethod{
+			{Id: key1, Type: Ed25519VerificationKey2020},
+			{Id: key2, Type: Ed25519VerificationKey2020},
+		},
+	}
+	require.Equal(t, []string{key1, key2}, legacyDid.GetCapabilityInvocationMethods(true))
+	require.Equal(t, []string{key1, key2}, legacyDid.GetCapabilityInvocationMethods(false))
+
+	legacyDid.AssertionMethod = VerificationMethodReferences(key2)
+	require.Empty(t, legacyDid.GetCapabilityInvocationMethods(true))
+}
+
+func TestReplaceIdsInVerificationRelationships(t *testing.T) {
+	did := Did{
+		Id:                   ValidTestDID,
+		Authentication:       VerificationMethodReferences(ValidTestDID+"#key-1", ValidTestDID2+"#key-1"),
+		CapabilityInvocation: VerificationMethodReferences(ValidTestDID + "#key-1"),
+		KeyAgreement:         VerificationMethodReferences(ValidTestDID + "#key-2"),
+	}
+
+	did.ReplaceIds(ValidTestDID, ValidTestDID+"-updated")
+
+	require.Equal(t, VerificationMethodReferences(ValidTestDID+"-updated#key-1", ValidTestDID2+"#key-1"), did.Authentication)
+	require.Equal(t, VerificationMethodReferences(ValidTestDID+"-updated#key-1"), did.CapabilityInvocation)
+	require.Equal(t, VerificationMethodReferences(ValidTestDID+"-updated#key-2"), did.KeyAgreement)
+}
+
+func TestReplaceIdsInEmbeddedVerificationMethods(t *testing.T) {
+	did := Did{
+		Id: ValidTestDID,
+		CapabilityInvocation: []*VerificationRelationship{
+			NewEmbeddedVerificationMethod(&VerificationMethod{
+				Id:         ValidTestDID + "#key-1",
+				Type:       Ed25519VerificationKey2020,
+				Controller: ValidTestDID,
+			}),
+		},
+	}
+
+	did.ReplaceIds(ValidTestDID, ValidTestDID+"-updated")
+
+	embedded := did.CapabilityInvocation[0].GetVerificationMethod()
+	require.Equal(t, ValidTestDID+"-updated#key-1", embedded.Id)
+	require.Equal(t, ValidTestDID+"-updated", embedded.Controller)
+	require.Equal(t, []string{ValidTestDID + "-updated#key-1"}, did.GetCapabilityInvocationMethods(false))
+	require.Equal(t, []string{ValidTestDID + "-updated"}, did.AllControllerDids())
+}
+
+func TestGetJSONLDContext(t *testing.T) {
+	did := Did{
+		Id: ValidTestDID,
+		VerificationMethod: []*VerificationMethod{
+			{Id: ValidTestDID + "#key-1", Type: Ed25519VerificationKey2020},
+			{Id: ValidTestDID + "#key-2", Type: Ed25519VerificationKey2020},
+		},
+		KeyAgreement: []*VerificationRelationship{
+			NewEmbeddedVerificationMethod(&VerificationMethod{Id: ValidTestDID + "#key-3", Type: JsonWebKey2020}),
+		},
+	}
+
+	// The DID Core context is added first
+	require.Equal(t, []string{
+		DidCoreContext,
+		"https://w3id.org/security/suites/ed25519-2020/v1",
+		"https://w3id.org/security/suites/jws-2020/v1",
+	}, did.GetJSONLDContext())
+
+	did.Context = []string{"https://example.com/context/v1", DidCoreContext}
+	require.Equal(t, []string{
+		DidCoreContext,
+		"https://example.com/context/v1",
+		"https://w3id.org/security/suites/ed25519-2020/v1",
+		"https://w3id.org/security/suites/jws-2020/v1",
+	}, did.GetJSONLDContext())
+}
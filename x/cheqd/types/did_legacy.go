@@ -0,0 +1,29 @@
+package types
+
+// Verification relationships were encoded as lists of verification method ids before v0.6.
+// They are read from the legacy fields and merged into the lists of VerificationRelationship.
+
+// mergeLegacyVerificationRelationship converts legacy ids to references placed before the relationship
+func mergeLegacyVerificationRelationship(legacy []string, relationship []*VerificationRelationship) []*VerificationRelationship {
+	if len(legacy) == 0 {
+		return relationship
+	}
+
+	return append(VerificationMethodReferences(legacy...), relationship...)
+}
+
+// MergeLegacyVerificationRelationships moves verification relationships encoded as lists of ids
+// to the verification relationships of the DID Doc
+func (did *Did) MergeLegacyVerificationRelationships() {
+	did.Authentication = mergeLegacyVerificationRelationship(did.LegacyAuthentication, did.Authentication)
+	did.AssertionMethod = mergeLegacyVerificationRelationship(did.LegacyAssertionMethod, did.AssertionMethod)
+	did.CapabilityInvocation = mergeLegacyVerificationRelationship(did.LegacyCapabilityInvocation, did.CapabilityInvocation)
+	did.CapabilityDelegation = mergeLegacyVerificationRelationship(did.LegacyCapabilityDelegation, did.CapabilityDelegation)
+	did.KeyAgreement = mergeLegacyVerificationRelationship(did.LegacyKeyAgreement, did.KeyAgreement)
+
+	did.LegacyAuthentication = nil
+	did.LegacyAssertionMethod = nil
+	did.LegacyCapabilityInvocation = nil
+	did.LegacyCapabilityDelegation = nil
+	did.LegacyKeyAgreement = nil
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeLegacyVerificationRelationships(t *testing.T) {
+	key1 := ValidTestDID + "#key-1"
+	key2 := ValidTestDID + "#key-2"
+
+	did := Did{
+		Context:    []string{"https://www.w3.org/ns/did/v1"},
+		Id:         ValidTestDID,
+		Controller: []string{ValidTestDID},
+		VerificationMethod: []*VerificationMethod{
+			{
+				Id:                 key1,
+				Type:               Ed25519VerificationKey2020,
+				Controller:         ValidTestDID,
+				PublicKeyMultibase: ValidEd25519PubKey,
+			},
+		},
+		Service:     []*Service{{Id: ValidTestDID + "#service-1", Type: "LinkedDomains", ServiceEndpoint: "https://example.com"}},
+		AlsoKnownAs: []string{"https://example.com"},
+	}
+
+	// Relationships were lists of ids, encoded with the same field numbers as the legacy fields
+	legacyDid := did
+	legacyDid.LegacyAuthentication = []string{key1, key2}
+	legacyDid.LegacyCapabilityInvocation = []string{key1}
+	legacyDid.LegacyKeyAgreement = []string{key2}
+
+	bz, err := legacyDid.Marshal()
+	require.NoError(t, err)
+
+	var result Did
+	require.NoError(t, result.Unmarshal(bz))
+	result.MergeLegacyVerificationRelationships()
+
+	expected := did
+	expected.Authentication = VerificationMethodReferences(key1, key2)
+	expected.CapabilityInvocation = VerificationMethodReferences(key1)
+	expected.KeyAgreement = VerificationMethodReferences(key2)
+
+	require.Equal(t, expected, result)
+
+	// Legacy references are placed before the current ones
+	result.LegacyAssertionMethod = []string{key1}
+	result.AssertionMethod = VerificationMethodReferences(key2)
+	result.MergeLegacyVerificationRelationships()
+
+	require.Equal(t, VerificationMethodReferences(key1, key2), result.AssertionMethod)
+	require.Empty(t, result.LegacyAssertionMethod)
+}
+
+func TestLegacyPayload(t *testing.T) {
+	key1 := ValidTestDID + "#key-1"
+
+	// Payload signed by a client built before v0.6
+	legacyPayload := MsgCreateDidPayload{
+		Id: ValidTestDID,
+		VerificationMethod: []*VerificationMethod{
+			{
+				Id:                 key1,
+				Type:               Ed25519VerificationKey2020,
+				Controller:         ValidTestDID,
+				PublicKeyMultibase: ValidEd25519PubKey,
+			},
+		},
+		LegacyAuthentication:       []string{key1},
+		LegacyCapabilityInvocation: []string{key1},
+	}
+
+	bz, err := legacyPayload.Marshal()
+	require.NoError(t, err)
+
+	var payload MsgCreateDidPayload
+	require.NoError(t, payload.Unmarshal(bz))
+
+	// Signatures made by legacy clients stay valid
+	require.Equal(t, legacyPayload.GetSignBytes(), payload.GetSignBytes())
+
+	did := payload.ToDid()
+	require.Equal(t, VerificationMethodReferences(key1), did.Authentication)
+	require.Equal(t, VerificationMethodReferences(key1), did.CapabilityInvocation)
+	require.Empty(t, did.LegacyAuthentication)
+	require.NoError(t, did.Validate(nil))
+}
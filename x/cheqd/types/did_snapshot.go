@@ -0,0 +1,169 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+const (
+	// DidSnapshotFormat is the format of DID snapshots written by this version
+	DidSnapshotFormat uint32 = 1
+
+	// Max size of a single item in a DID snapshot
+	MaxDidSnapshotItemSize = 16 * 1024 * 1024
+)
+
+// NewDidSnapshotWriter returns a writer of DID snapshots.
+// A DID snapshot is a stream of length-delimited protobuf messages: DidSnapshotHeader followed by DidSnapshotItem's.
+// Items contain the DID Docs count and all DID Docs of the module store.
+func NewDidSnapshotWriter(writer io.Writer) protoio.WriteCloser {
+	return protoio.NewDelimitedWriter(writer)
+}
+
+// VerifyDidSnapshot reads a DID snapshot and checks that it contains all DID Docs of the module store proven
+// against the trusted app hash. The app hash of the state at the snapshot height is contained in the header
+// of the next block. The callback is called for each DID Doc after its proof is verified.
+func VerifyDidSnapshot(reader io.Reader, appHash []byte, cb func(stateValue StateValue) error) (*DidSnapshotHeader, error) {
+	protoReader := protoio.NewDelimitedReader(reader, MaxDidSnapshotItemSize)
+
+	registry := cdctypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+
+	var header DidSnapshotHeader
+	if err := protoReader.ReadMsg(&header); err != nil {
+		return nil, err
+	}
+
+	if header.Format != DidSnapshotFormat {
+		return nil, ErrInvalidProof.Wrapf("unsupported snapshot format: %d", header.Format)
+	}
+
+	root, err := VerifyProofOp(header.StoreProof, storetypes.ProofOpSimpleMerkleCommitment, KeyPrefix(StoreKey), header.StoreHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(root, appHash) {
+		return nil, ErrInvalidProof.Wrapf("store hash is proven against %X, not against the app hash", root)
+	}
+
+	var expectedCount *uint64
+	var count uint64
+	var lastKey []byte
+
+	for {
+		var item DidSnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		root, err := VerifyProofOp(item.Proof, storetypes.ProofOpIAVLCommitment, item.Key, item.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(root, header.StoreHash) {
+			return nil, ErrInvalidProof.Wrapf("key %s is proven against %X, not against the store hash", item.Key, root)
+		}
+
+		if bytes.Equal(item.Key, GetDidCountStoreKey()) {
+			if expectedCount != nil {
+				return nil, ErrInvalidProof.Wrap("duplicated DID Docs count")
+			}
+
+			// Count is absent if there are no DID Docs
+			var parsed uint64
+			if item.Value != nil {
+				parsed, err = strconv.ParseUint(string(item.Value), 10, 64)
+				if err != nil {
+					return nil, ErrInvalidProof.Wrapf("invalid DID Docs count: %s", item.Value)
+				}
+			}
+
+			expectedCount = &parsed
+			continue
+		}
+
+		if !bytes.HasPrefix(item.Key, KeyPrefix(DidKey)) {
+			return nil, ErrInvalidProof.Wrapf("unexpected key: %s", item.Key)
+		}
+
+		// Sorted keys guarantee that each DID Doc is counted once
+		if lastKey != nil && bytes.Compare(item.Key, lastKey) <= 0 {
+			return nil, ErrInvalidProof.Wrapf("DID Docs are not sorted by key: %s", item.Key)
+		}
+
+		lastKey = item.Key
+		count++
+
+		var stateValue StateValue
+		if err := stateValue.Unmarshal(item.Value); err != nil {
+			return nil, ErrUnpackStateValue.Wrapf("key: %s, err: %s", item.Key, err.Error())
+		}
+
+		if err := stateValue.UnpackInterfaces(registry); err != nil {
+			return nil, ErrUnpackStateValue.Wrapf("key: %s, err: %s", item.Key, err.Error())
+		}
+
+		if cb != nil {
+			if err := cb(stateValue); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if expectedCount == nil {
+		return nil, ErrInvalidProof.Wrap("DID Docs count is missing")
+	}
+
+	if *expectedCount != count {
+		return nil, ErrInvalidProof.Wrapf("snapshot contains %d DID Docs, expected: %d", count, *expectedCount)
+	}
+
+	return &header, nil
+}
+
+// VerifyProofOp checks that the ICS-23 proof of the given type proves the key-value pair,
+// or the absence of the key if the value is nil. Returns the root the proof is calculated against.
+func VerifyProofOp(proofOp *crypto.ProofOp, proofType string, key []byte, value []byte) ([]byte, error) {
+	if proofOp == nil {
+		return nil, ErrInvalidProof.Wrapf("proof is missing for key: %s", key)
+	}
+
+	if proofOp.Type != proofType {
+		return nil, ErrInvalidProof.Wrapf("unexpected proof type: %s, expected: %s", proofOp.Type, proofType)
+	}
+
+	if !bytes.Equal(proofOp.Key, key) {
+		return nil, ErrInvalidProof.Wrapf("proof is for key %s, expected: %s", proofOp.Key, key)
+	}
+
+	op, err := storetypes.CommitmentOpDecoder(*proofOp)
+	if err != nil {
+		return nil, ErrInvalidProof.Wrap(err.Error())
+	}
+
+	var args [][]byte
+	if value != nil {
+		args = append(args, value)
+	}
+
+	roots, err := op.Run(args)
+	if err != nil {
+		return nil, ErrInvalidProof.Wrap(err.Error())
+	}
+
+	return roots[0], nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"crypto"
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/multiformats/go-multibase"
+)
+
+const (
+	UniqueIdFormatBase58         = "base58"
+	UniqueIdFormatUUID           = "uuid"
+	UniqueIdFormatSelfCertifying = "self-certifying"
+)
+
+var SupportedUniqueIdFormats = []string{
+	UniqueIdFormatBase58,
+	UniqueIdFormatUUID,
+	UniqueIdFormatSelfCertifying,
+}
+
+// Helpers
+
+// SelfCertifyingUniqueId derives the unique id from the key of the verification method.
+// Multibase keys are hashed as is, JWKs are hashed as their RFC 7638 thumbprints.
+func (vm VerificationMethod) SelfCertifyingUniqueId() (string, error) {
+	var keyBytes []byte
+
+	switch {
+	case utils.Contains(MultibaseMethodTypes, vm.Type):
+		_, decoded, err := multibase.Decode(vm.PublicKeyMultibase)
+		if err != nil {
+			return "", err
+		}
+
+		keyBytes = decoded
+
+	case utils.Contains(JwkMethodTypes, vm.Type):
+		keyJson, err := PubKeyJWKToJson(vm.PublicKeyJwk)
+		if err != nil {
+			return "", err
+		}
+
+		key, err := jwk.ParseKey([]byte(keyJson))
+		if err != nil {
+			return "", fmt.Errorf("can't parse jwk: %s", err.Error())
+		}
+
+		keyBytes, err = key.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return "", err
+		}
+
+	default:
+		return "", fmt.Errorf("unsupported verification method type: %s", vm.Type)
+	}
+
+	return utils.SelfCertifyingUniqueId(keyBytes), nil
+}
+
+// Validation
+
+// ValidateUniqueIdFormat checks the syntax of the unique id. Self-certifying ids must also be derived from a key,
+// which requires the DID Doc and the signatures.
+func ValidateUniqueIdFormat(uniqueId string, format string) error {
+	switch format {
+	case UniqueIdFormatBase58:
+		return utils.ValidateBase58UniqueId(uniqueId)
+	case UniqueIdFormatUUID:
+		if !utils.IsUUID(uniqueId) {
+			return fmt.Errorf("unique id must be a lowercase UUID")
+		}
+
+		return nil
+	case UniqueIdFormatSelfCertifying:
+		if len(uniqueId) != utils.SelfCertifyingUniqueIdLength {
+			return fmt.Errorf("self-certifying unique id length should be %d symbols", utils.SelfCertifyingUniqueIdLength)
+		}
+
+		return utils.ValidateBase58UniqueId(uniqueId)
+	default:
+		return fmt.Errorf("unsupported unique id format: %s", format)
+	}
+}
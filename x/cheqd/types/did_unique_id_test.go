@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUniqueIdFormat(t *testing.T) {
+	cases := []struct {
+		valid    bool
+		name     string
+		uniqueId string
+		format   string
+	}{
+		{true, "Valid: base58 id", "123456789abcdefg", UniqueIdFormatBase58},
+		{false, "Not valid: UUID isn't base58", "3b9b8eec-5b5d-4382-86d8-9185126ff130", UniqueIdFormatBase58},
+		{true, "Valid: UUID", "3b9b8eec-5b5d-4382-86d8-9185126ff130", UniqueIdFormatUUID},
+		{false, "Not valid: UUID in upper case", "3B9B8EEC-5B5D-4382-86D8-9185126FF130", UniqueIdFormatUUID},
+		{true, "Valid: self-certifying id", "123456789abcdefg123456789abcdefg", UniqueIdFormatSelfCertifying},
+		{false, "Not valid: self-certifying id is too short", "123456789abcdefg", UniqueIdFormatSelfCertifying},
+		{false, "Not valid: unsupported format", "123456789abcdefg", "hex"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateUniqueIdFormat(tc.uniqueId, tc.format)
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSelfCertifyingUniqueId(t *testing.T) {
+	vm := VerificationMethod{Type: Ed25519VerificationKey2020, PublicKeyMultibase: ValidEd25519PubKey}
+	uniqueId, err := vm.SelfCertifyingUniqueId()
+	require.NoError(t, err)
+	require.Equal(t, utils.SelfCertifyingUniqueId(base58.Decode(ValidEd25519PubKey[1:])), uniqueId)
+	require.NoError(t, ValidateUniqueIdFormat(uniqueId, UniqueIdFormatSelfCertifying))
+
+	vm = VerificationMethod{Type: JsonWebKey2020, PublicKeyJwk: ValidPublicKeyJWK}
+	uniqueId, err = vm.SelfCertifyingUniqueId()
+	require.NoError(t, err)
+	require.NoError(t, ValidateUniqueIdFormat(uniqueId, UniqueIdFormatSelfCertifying))
+
+	vm = VerificationMethod{Type: "UnknownKey", PublicKeyMultibase: ValidEd25519PubKey}
+	_, err = vm.SelfCertifyingUniqueId()
+	require.Error(t, err)
+}
@@ -15,13 +15,19 @@ import (
 )
 
 const (
-	JsonWebKey2020             = "JsonWebKey2020"
-	Ed25519VerificationKey2020 = "Ed25519VerificationKey2020"
+	JsonWebKey2020                    = "JsonWebKey2020"
+	Ed25519VerificationKey2020        = "Ed25519VerificationKey2020"
+	EcdsaSecp256k1VerificationKey2019 = "EcdsaSecp256k1VerificationKey2019"
+	X25519KeyAgreementKey2020         = "X25519KeyAgreementKey2020"
+	X25519KeyAgreementKey2019         = "X25519KeyAgreementKey2019"
 )
 
 var SupportedMethodTypes = []string{
 	JsonWebKey2020,
 	Ed25519VerificationKey2020,
+	EcdsaSecp256k1VerificationKey2019,
+	X25519KeyAgreementKey2020,
+	X25519KeyAgreementKey2019,
 }
 
 var JwkMethodTypes = []string{
@@ -30,6 +36,15 @@ var JwkMethodTypes = []string{
 
 var MultibaseMethodTypes = []string{
 	Ed25519VerificationKey2020,
+	EcdsaSecp256k1VerificationKey2019,
+	X25519KeyAgreementKey2020,
+	X25519KeyAgreementKey2019,
+}
+
+// KeyAgreementMethodTypes can only be used for key agreement, e.g. by DIDComm, and never for signing
+var KeyAgreementMethodTypes = []string{
+	X25519KeyAgreementKey2020,
+	X25519KeyAgreementKey2019,
 }
 
 func NewVerificationMethod(id string, type_ string, controller string, publicKeyJwk []*KeyValuePair, publicKeyMultibase string) *VerificationMethod {
@@ -65,6 +80,10 @@ func GetVerificationMethodIds(vms []*VerificationMethod) []string {
 }
 
 func VerifySignature(vm VerificationMethod, message []byte, signature []byte) error {
+	if vm.IsKeyAgreementMethod() {
+		return ErrInvalidSignature.Wrapf("verification method: %s, err: key agreement keys can't be used for signing", vm.Id)
+	}
+
 	var verificationError error
 
 	switch vm.Type {
@@ -76,6 +95,14 @@ func VerifySignature(vm VerificationMethod, message []byte, signature []byte) er
 
 		verificationError = utils.VerifyED25519Signature(keyBytes, message, signature)
 
+	case EcdsaSecp256k1VerificationKey2019:
+		_, keyBytes, err := multibase.Decode(vm.PublicKeyMultibase)
+		if err != nil {
+			return err
+		}
+
+		verificationError = utils.VerifySecp256k1Signature(keyBytes, message, signature)
+
 	case JsonWebKey2020:
 		keyJson, err := PubKeyJWKToJson(vm.PublicKeyJwk)
 		if err != nil {
@@ -110,6 +137,16 @@ func VerifySignature(vm VerificationMethod, message []byte, signature []byte) er
 	return nil
 }
 
+// IsKeyAgreementMethod checks whether the key of the verification method can be used only for key agreement.
+// Besides key agreement method types, it's true for OKP X25519 JWKs.
+func (vm VerificationMethod) IsKeyAgreementMethod() bool {
+	if utils.Contains(KeyAgreementMethodTypes, vm.Type) {
+		return true
+	}
+
+	return vm.Type == JsonWebKey2020 && PubKeyJWKToMap(vm.PublicKeyJwk)["crv"] == "X25519"
+}
+
 func VerificationMethodListToMapByFragment(vms []*VerificationMethod) map[string]VerificationMethod {
 	result := map[string]VerificationMethod{}
 
@@ -139,7 +176,10 @@ func (vm VerificationMethod) Validate(baseDid string, allowedNamespaces []string
 			validation.When(utils.Contains(JwkMethodTypes, vm.Type), validation.Required, IsUniqueKeyValuePairListByKeyRule(), IsJWK()).Else(validation.Empty),
 		),
 		validation.Field(&vm.PublicKeyMultibase,
-			validation.When(utils.Contains(MultibaseMethodTypes, vm.Type), validation.Required, IsMultibase(), IsMultibaseEncodedEd25519PubKey()).Else(validation.Empty),
+			validation.When(utils.Contains(MultibaseMethodTypes, vm.Type), validation.Required, IsMultibase()).Else(validation.Empty),
+			validation.When(vm.Type == Ed25519VerificationKey2020, IsMultibaseEncodedEd25519PubKey()),
+			validation.When(vm.Type == EcdsaSecp256k1VerificationKey2019, IsMultibaseEncodedSecp256k1PubKey()),
+			validation.When(utils.Contains(KeyAgreementMethodTypes, vm.Type), IsMultibaseEncodedX25519PubKey()),
 		),
 	)
 }
@@ -155,6 +195,28 @@ func ValidVerificationMethodRule(baseDid string, allowedNamespaces []string) *Cu
 	})
 }
 
+// IsNotKeyAgreementMethodRule checks that the referenced or embedded verification method can be used for signing
+func IsNotKeyAgreementMethodRule(vms []*VerificationMethod) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(VerificationRelationship)
+		if !ok {
+			panic("IsNotKeyAgreementMethodRule must be only applied on verification relationships")
+		}
+
+		if embedded := casted.GetVerificationMethod(); embedded != nil && embedded.IsKeyAgreementMethod() {
+			return errors.New("key agreement verification method can be referenced only in key agreement")
+		}
+
+		for _, vm := range vms {
+			if vm.Id == casted.GetId() && vm.IsKeyAgreementMethod() {
+				return errors.New("key agreement verification method can be referenced only in key agreement")
+			}
+		}
+
+		return nil
+	})
+}
+
 func IsUniqueVerificationMethodListByIdRule() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.([]*VerificationMethod)
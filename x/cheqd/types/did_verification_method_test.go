@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/multiformats/go-multibase"
 	"github.com/stretchr/testify/require"
@@ -42,6 +43,17 @@ var NotValidJWKKey = TestJWKKey{
 	Kid: "6a8ba5652a7044121d4fedac8f14d14c54e4895b",
 }
 
+var (
+	ValidSecp256k1PubKey = "zgju4X9v6VYeEw9XsYmFyinr1KGr6f8A6CpGLwipxb859"
+	ValidX25519PubKey    = "zDcCskRPRTix8toyKQ9VjXKaPNmiTZUY3f4J7pMPqWeK5"
+	LowOrderX25519PubKey = "z11111111111111111111111111111111"
+	ValidX25519PubKeyJWK = []*KeyValuePair{
+		{Key: "kty", Value: "OKP"},
+		{Key: "crv", Value: "X25519"},
+		{Key: "x", Value: "u1D_noKldM-_gg6X9g-5wUPsdBXPUU-M_Zjv9Z4FlhQ"},
+	}
+)
+
 var (
 	ValidJWKByte, _    = json.Marshal(ValidJWKKey)
 	NotValidJWKByte, _ = json.Marshal(NotValidJWKKey)
@@ -171,6 +183,90 @@ func TestVerificationMethodValidation(t *testing.T) {
 			isValid:  false,
 			errorMsg: "public_key_jwk: (6: (key: cannot be blank; value: cannot be blank.).).",
 		},
+		{
+			name: "EcdsaSecp256k1VerificationKey2019: valid key",
+			struct_: VerificationMethod{
+				Id:                 "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:               "EcdsaSecp256k1VerificationKey2019",
+				Controller:         "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyMultibase: ValidSecp256k1PubKey,
+			},
+			isValid: true,
+		},
+		{
+			name: "EcdsaSecp256k1VerificationKey2019: ed25519 key",
+			struct_: VerificationMethod{
+				Id:                 "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:               "EcdsaSecp256k1VerificationKey2019",
+				Controller:         "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyMultibase: ValidEd25519PubKey,
+			},
+			isValid:  false,
+			errorMsg: "public_key_multibase: secp256k1: bad public key length: 32.",
+		},
+		{
+			name: "X25519KeyAgreementKey2020: valid key",
+			struct_: VerificationMethod{
+				Id:                 "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:               "X25519KeyAgreementKey2020",
+				Controller:         "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyMultibase: ValidX25519PubKey,
+			},
+			isValid: true,
+		},
+		{
+			name: "X25519KeyAgreementKey2019: valid key",
+			struct_: VerificationMethod{
+				Id:                 "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:               "X25519KeyAgreementKey2019",
+				Controller:         "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyMultibase: ValidX25519PubKey,
+			},
+			isValid: true,
+		},
+		{
+			name: "X25519KeyAgreementKey2020: bad key length",
+			struct_: VerificationMethod{
+				Id:                 "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:               "X25519KeyAgreementKey2020",
+				Controller:         "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyMultibase: NotValidEd25519PubKey,
+			},
+			isValid:  false,
+			errorMsg: "public_key_multibase: x25519: bad public key length: 18.",
+		},
+		{
+			name: "X25519KeyAgreementKey2020: low order point",
+			struct_: VerificationMethod{
+				Id:                 "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:               "X25519KeyAgreementKey2020",
+				Controller:         "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyMultibase: LowOrderX25519PubKey,
+			},
+			isValid:  false,
+			errorMsg: "public_key_multibase: x25519: bad input point: low order point.",
+		},
+		{
+			name: "X25519KeyAgreementKey2020: jwk is not allowed",
+			struct_: VerificationMethod{
+				Id:           "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:         "X25519KeyAgreementKey2020",
+				Controller:   "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyJwk: ValidX25519PubKeyJWK,
+			},
+			isValid:  false,
+			errorMsg: "public_key_jwk: must be blank; public_key_multibase: cannot be blank.",
+		},
+		{
+			name: "JWK: valid X25519 key",
+			struct_: VerificationMethod{
+				Id:           "did:cheqd:aaaaaaaaaaaaaaaa#qwe",
+				Type:         "JsonWebKey2020",
+				Controller:   "did:cheqd:bbbbbbbbbbbbbbbb",
+				PublicKeyJwk: ValidX25519PubKeyJWK,
+			},
+			isValid: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -228,6 +324,25 @@ func TestEd25519SignatureVerification(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSecp256k1SignatureVerification(t *testing.T) {
+	msgBytes := []byte("Lorem ipsum dolor sit amet, consectetur adipiscing elit.")
+
+	privKey := secp256k1.GenPrivKey()
+	signature, err := privKey.Sign(msgBytes)
+	require.NoError(t, err)
+
+	pubKeyStr, err := multibase.Encode(multibase.Base58BTC, privKey.PubKey().Bytes())
+	require.NoError(t, err)
+
+	vm := VerificationMethod{
+		Type:               "EcdsaSecp256k1VerificationKey2019",
+		PublicKeyMultibase: pubKeyStr,
+	}
+
+	require.NoError(t, VerifySignature(vm, msgBytes, signature))
+	require.Error(t, VerifySignature(vm, []byte("another message"), signature))
+}
+
 func TestECDSASignatureVerification(t *testing.T) {
 	message := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod " +
 		"tempor incididunt ut labore et dolore magna aliqua."
@@ -295,3 +410,26 @@ func TestRSASignatureVerification(t *testing.T) {
 	err = VerifySignature(vm2, msgBytes, signature)
 	require.NoError(t, err)
 }
+
+func TestKeyAgreementMethodsCannotSign(t *testing.T) {
+	message := []byte("Lorem ipsum dolor sit amet")
+
+	cases := []VerificationMethod{
+		{Type: X25519KeyAgreementKey2020, PublicKeyMultibase: ValidX25519PubKey},
+		{Type: X25519KeyAgreementKey2019, PublicKeyMultibase: ValidX25519PubKey},
+		{Type: JsonWebKey2020, PublicKeyJwk: ValidX25519PubKeyJWK},
+	}
+
+	for _, vm := range cases {
+		t.Run(vm.Type, func(t *testing.T) {
+			require.True(t, vm.IsKeyAgreementMethod())
+
+			err := VerifySignature(vm, message, make([]byte, ed25519.SignatureSize))
+			require.ErrorIs(t, err, ErrInvalidSignature)
+			require.Contains(t, err.Error(), "key agreement keys can't be used for signing")
+		})
+	}
+
+	require.False(t, VerificationMethod{Type: JsonWebKey2020, PublicKeyJwk: ValidPublicKeyJWK}.IsKeyAgreementMethod())
+	require.False(t, VerificationMethod{Type: Ed25519VerificationKey2020, PublicKeyMultibase: ValidEd25519PubKey}.IsKeyAgreementMethod())
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+var (
+	_ jsonpb.JSONPBMarshaler   = &VerificationRelationship{}
+	_ jsonpb.JSONPBUnmarshaler = &VerificationRelationship{}
+)
+
+func NewVerificationMethodReference(verificationMethodId string) *VerificationRelationship {
+	return &VerificationRelationship{
+		Method: &VerificationRelationship_VerificationMethodId{VerificationMethodId: verificationMethodId},
+	}
+}
+
+func NewEmbeddedVerificationMethod(verificationMethod *VerificationMethod) *VerificationRelationship {
+	return &VerificationRelationship{
+		Method: &VerificationRelationship_VerificationMethod{VerificationMethod: verificationMethod},
+	}
+}
+
+// VerificationMethodReferences builds a verification relationship referencing verification methods by ids
+func VerificationMethodReferences(verificationMethodIds ...string) []*VerificationRelationship {
+	res := make([]*VerificationRelationship, len(verificationMethodIds))
+
+	for i, id := range verificationMethodIds {
+		res[i] = NewVerificationMethodReference(id)
+	}
+
+	return res
+}
+
+// Helpers
+
+// GetId returns the id of the referenced or embedded verification method
+func (vr *VerificationRelationship) GetId() string {
+	if vm := vr.GetVerificationMethod(); vm != nil {
+		return vm.Id
+	}
+
+	return vr.GetVerificationMethodId()
+}
+
+func (vr *VerificationRelationship) IsEmbedded() bool {
+	return vr.GetVerificationMethod() != nil
+}
+
+func GetVerificationRelationshipIds(vrs []*VerificationRelationship) []string {
+	res := make([]string, len(vrs))
+
+	for i := range vrs {
+		res[i] = vrs[i].GetId()
+	}
+
+	return res
+}
+
+func GetEmbeddedVerificationMethods(vrs []*VerificationRelationship) []*VerificationMethod {
+	var res []*VerificationMethod
+
+	for _, vr := range vrs {
+		if vr.IsEmbedded() {
+			res = append(res, vr.GetVerificationMethod())
+		}
+	}
+
+	return res
+}
+
+// JSON
+
+// MarshalJSONPB represents references as strings and embedded methods as objects, as in DID Core
+func (vr *VerificationRelationship) MarshalJSONPB(m *jsonpb.Marshaler) ([]byte, error) {
+	if vr.IsEmbedded() {
+		var buf bytes.Buffer
+		if err := m.Marshal(&buf, vr.GetVerificationMethod()); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return json.Marshal(vr.GetVerificationMethodId())
+}
+
+// UnmarshalJSONPB parses strings as references and objects as embedded methods
+func (vr *VerificationRelationship) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	var id string
+	if err := json.Unmarshal(bz, &id); err == nil {
+		*vr = *NewVerificationMethodReference(id)
+		return nil
+	}
+
+	var vm VerificationMethod
+	if err := u.Unmarshal(bytes.NewReader(bz), &vm); err != nil {
+		return err
+	}
+
+	*vr = *NewEmbeddedVerificationMethod(&vm)
+	return nil
+}
+
+// Validation
+
+func (vr VerificationRelationship) Validate(baseDid string, allowedNamespaces []string) error {
+	switch method := vr.Method.(type) {
+	case *VerificationRelationship_VerificationMethodId:
+		// References may point to verification methods of other DIDs
+		return validation.Validate(method.VerificationMethodId, validation.Required, IsDIDUrl(allowedNamespaces, Empty, Empty, Required))
+	case *VerificationRelationship_VerificationMethod:
+		if method.VerificationMethod == nil {
+			return errors.New("embedded verification method is required")
+		}
+
+		return method.VerificationMethod.Validate(baseDid, allowedNamespaces)
+	default:
+		return errors.New("either verification method id or embedded verification method is required")
+	}
+}
+
+func ValidVerificationRelationshipRule(baseDid string, allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(VerificationRelationship)
+		if !ok {
+			panic("ValidVerificationRelationshipRule must be only applied on verification relationships")
+		}
+
+		return casted.Validate(baseDid, allowedNamespaces)
+	})
+}
+
+// IsOwnVerificationMethodRule checks that the verification method belongs to the DID. It's applied to capabilityInvocation,
+// as the ledger authorizes changes on behalf of a DID only by methods listed in the DID's own DID Doc.
+func IsOwnVerificationMethodRule(did string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(VerificationRelationship)
+		if !ok {
+			panic("IsOwnVerificationMethodRule must be only applied on verification relationships")
+		}
+
+		// Malformed ids are reported by ValidVerificationRelationshipRule
+		methodDid, _, _, _, err := utils.TrySplitDIDUrl(casted.GetId())
+		if err != nil || methodDid == did {
+			return nil
+		}
+
+		return fmt.Errorf("verification method of another DID can't be used for capability invocation: %s", methodDid)
+	})
+}
+
+func IsUniqueVerificationRelationshipListByIdRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*VerificationRelationship)
+		if !ok {
+			panic("IsUniqueVerificationRelationshipListByIdRule must be only applied on verification relationships")
+		}
+
+		ids := GetVerificationRelationshipIds(casted)
+		if !utils.IsUnique(ids) {
+			return errors.New("there should be no duplicates")
+		}
+
+		return nil
+	})
+}
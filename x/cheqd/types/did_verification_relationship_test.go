@@ -0,0 +1,41 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerificationRelationshipJSON(t *testing.T) {
+	did := Did{
+		Id: ValidTestDID,
+		Authentication: []*VerificationRelationship{
+			NewVerificationMethodReference(ValidTestDID2 + "#key-1"),
+			NewEmbeddedVerificationMethod(&VerificationMethod{
+				Id:                 ValidTestDID + "#key-1",
+				Type:               Ed25519VerificationKey2020,
+				Controller:         ValidTestDID,
+				PublicKeyMultibase: ValidEd25519PubKey,
+			}),
+		},
+	}
+
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	didJson, err := marshaler.MarshalToString(&did)
+	require.NoError(t, err)
+
+	// References are strings, embedded methods are objects
+	require.Contains(t, didJson, `"authentication":["`+ValidTestDID2+`#key-1",{"id":"`+ValidTestDID+`#key-1",`)
+
+	var result Did
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(didJson), &result))
+	require.Equal(t, did, result)
+}
+
+func TestVerificationRelationshipJSONInvalid(t *testing.T) {
+	var result Did
+	err := jsonpb.Unmarshal(strings.NewReader(`{"authentication":[1]}`), &result)
+	require.Error(t, err)
+}
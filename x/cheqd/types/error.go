@@ -11,12 +11,23 @@ var (
 	ErrBadRequest                 = sdkerrors.Register(ModuleName, 1000, "bad request")
 	ErrInvalidSignature           = sdkerrors.Register(ModuleName, 1100, "invalid signature detected")
 	ErrSignatureNotFound          = sdkerrors.Register(ModuleName, 1101, "signature is required but not found")
+	ErrChainIdMismatch            = sdkerrors.Register(ModuleName, 1102, "payload is signed for another chain")
+	ErrPayloadExpired             = sdkerrors.Register(ModuleName, 1103, "payload expired")
+	ErrUnauthorizedMethod         = sdkerrors.Register(ModuleName, 1104, "verification method is not authorized for capability invocation")
+	ErrUnexpectedSigner           = sdkerrors.Register(ModuleName, 1105, "operation must be submitted by the account the DID is bound to")
 	ErrDidDocExists               = sdkerrors.Register(ModuleName, 1200, "DID Doc exists")
 	ErrDidDocNotFound             = sdkerrors.Register(ModuleName, 1201, "DID Doc not found")
 	ErrVerificationMethodNotFound = sdkerrors.Register(ModuleName, 1202, "verification method not found")
 	ErrUnexpectedDidVersion       = sdkerrors.Register(ModuleName, 1203, "unexpected DID version")
 	ErrBasicValidation            = sdkerrors.Register(ModuleName, 1205, "basic validation failed")
 	ErrNamespaceValidation        = sdkerrors.Register(ModuleName, 1206, "DID namespace validation failed")
+	ErrDidDocLimitExceeded        = sdkerrors.Register(ModuleName, 1207, "DID Doc exceeds limits")
+	ErrContextNotAllowed          = sdkerrors.Register(ModuleName, 1208, "JSON-LD context is not allowed")
+	ErrInvalidUniqueId            = sdkerrors.Register(ModuleName, 1209, "unique id format is not allowed in the namespace")
+	ErrDidDocDeactivated          = sdkerrors.Register(ModuleName, 1210, "DID Doc is deactivated")
 	ErrUnpackStateValue           = sdkerrors.Register(ModuleName, 1300, "invalid did state value")
+	ErrInvalidProof               = sdkerrors.Register(ModuleName, 1301, "invalid state proof")
+	ErrInvalidPacket              = sdkerrors.Register(ModuleName, 1400, "invalid packet")
+	ErrInvalidVersion             = sdkerrors.Register(ModuleName, 1401, "invalid cheqd IBC version")
 	ErrInternal                   = sdkerrors.Register(ModuleName, 1500, "internal error")
 )
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// ErrorDetailsSeparator separates the human-readable part of an error message from its JSON encoded details
+const ErrorDetailsSeparator = " | details: "
+
+// FieldError describes a validation failure of a single field. Path is built from json names of the fields
+// and indexes of list items, e.g. payload.verification_method.0.controller
+type FieldError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ErrorDetails contains machine-readable details of an identity error
+type ErrorDetails struct {
+	Fields               []FieldError `json:"fields,omitempty"`
+	VerificationMethodId string       `json:"verification_method_id,omitempty"`
+	Signer               string       `json:"signer,omitempty"`
+	RequiredSigners      []string     `json:"required_signers,omitempty"`
+	ProvidedSigners      []string     `json:"provided_signers,omitempty"`
+}
+
+// detailedError attaches details to an error. Details are appended to the error message,
+// so they end up in the ABCI log, while the code and codespace of the wrapped error are preserved.
+type detailedError struct {
+	err     error
+	details ErrorDetails
+}
+
+func (e *detailedError) Error() string {
+	bz, err := json.Marshal(e.details)
+	if err != nil {
+		return e.err.Error()
+	}
+
+	return e.err.Error() + ErrorDetailsSeparator + string(bz)
+}
+
+func (e *detailedError) Cause() error {
+	return e.err
+}
+
+func (e *detailedError) Unwrap() error {
+	return e.err
+}
+
+// WithDetails attaches details to the error
+func WithDetails(err error, details ErrorDetails) error {
+	if err == nil {
+		return nil
+	}
+
+	return &detailedError{err: err, details: details}
+}
+
+// GetErrorDetails extracts details from the error chain
+func GetErrorDetails(err error) (ErrorDetails, bool) {
+	var detailed *detailedError
+	if !errors.As(err, &detailed) {
+		return ErrorDetails{}, false
+	}
+
+	return detailed.details, true
+}
+
+// ParseErrorDetails extracts details from an error message, e.g. from the log of a failed transaction
+func ParseErrorDetails(log string) (ErrorDetails, bool) {
+	_, details, found := splitErrorDetails(log)
+	return details, found
+}
+
+// TrimErrorDetails returns the human-readable part of an error message
+func TrimErrorDetails(log string) string {
+	message, _, _ := splitErrorDetails(log)
+	return message
+}
+
+func splitErrorDetails(log string) (string, ErrorDetails, bool) {
+	// Messages of nested errors may contain the separator, so try every occurrence
+	for offset := 0; ; {
+		idx := strings.Index(log[offset:], ErrorDetailsSeparator)
+		if idx == -1 {
+			return log, ErrorDetails{}, false
+		}
+
+		idx += offset
+		offset = idx + len(ErrorDetailsSeparator)
+
+		var details ErrorDetails
+		if err := json.Unmarshal([]byte(log[offset:]), &details); err == nil {
+			return log[:idx], details, true
+		}
+	}
+}
+
+// ValidationErrorDetails converts ozzo-validation errors to details with a field path for each failure
+func ValidationErrorDetails(err error) ErrorDetails {
+	return ErrorDetails{Fields: validationFieldErrors("", err)}
+}
+
+func validationFieldErrors(path string, err error) []FieldError {
+	var errs validation.Errors
+	if !errors.As(err, &errs) {
+		return []FieldError{{Path: path, Message: err.Error()}}
+	}
+
+	keys := make([]string, 0, len(errs))
+	for key := range errs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var res []FieldError
+	for _, key := range keys {
+		if errs[key] == nil {
+			continue
+		}
+
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+
+		res = append(res, validationFieldErrors(fieldPath, errs[key])...)
+	}
+
+	return res
+}
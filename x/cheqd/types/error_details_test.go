@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorDetails(t *testing.T) {
+	details := ErrorDetails{
+		VerificationMethodId: "did:cheqd:test:aaaaaaaaaaaaaaaa#key-1",
+		RequiredSigners:      []string{"did:cheqd:test:aaaaaaaaaaaaaaaa"},
+	}
+
+	err := WithDetails(ErrInvalidSignature.Wrap("method id: did:cheqd:test:aaaaaaaaaaaaaaaa#key-1"), details)
+	wrapped := sdkerrors.Wrap(err, "failed to execute message; message index: 0")
+
+	// Code and codespace are preserved
+	codespace, code, log := sdkerrors.ABCIInfo(wrapped, false)
+	require.Equal(t, ModuleName, codespace)
+	require.Equal(t, ErrInvalidSignature.ABCICode(), code)
+	require.ErrorIs(t, wrapped, ErrInvalidSignature)
+
+	// Details can be extracted from the error and from the log
+	extracted, found := GetErrorDetails(wrapped)
+	require.True(t, found)
+	require.Equal(t, details, extracted)
+
+	parsed, found := ParseErrorDetails(log)
+	require.True(t, found)
+	require.Equal(t, details, parsed)
+
+	require.Equal(t, "failed to execute message; message index: 0: method id: did:cheqd:test:aaaaaaaaaaaaaaaa#key-1: invalid signature detected", TrimErrorDetails(log))
+
+	_, found = ParseErrorDetails(ErrInvalidSignature.Error())
+	require.False(t, found)
+}
+
+func TestValidationErrorDetails(t *testing.T) {
+	msg := MsgCreateDid{
+		Payload: &MsgCreateDidPayload{
+			Id:             "did:cheqd:test:aaaaaaaaaaaaaaaa",
+			Controller:     []string{"did:cheqd:test:aaaaaaaaaaaaaaaa"},
+			Authentication: VerificationMethodReferences("did:cheqd:test:aaaaaaaaaaaaaaaa#key-1", "did:cheqd:test:aaaaaaaaaaaaaaaa#key-1"),
+			VerificationMethod: []*VerificationMethod{
+				{
+					Id:                 "did:cheqd:test:aaaaaaaaaaaaaaaa#key-1",
+					Type:               Ed25519VerificationKey2020,
+					Controller:         "did:cheqd:test:invalid",
+					PublicKeyMultibase: ValidEd25519PubKey,
+				},
+			},
+		},
+	}
+
+	details, found := GetErrorDetails(msg.ValidateBasic())
+	require.True(t, found)
+	require.Equal(t, []FieldError{
+		{Path: "payload.authentication", Message: "there should be no duplicates"},
+		{Path: "payload.verification_method.0.controller", Message: "unique id length should be 16 or 32 symbols"},
+	}, details.Fields)
+}
@@ -0,0 +1,11 @@
+package types
+
+// IBC events
+const (
+	EventTypePacket  = "cheqd_packet"
+	EventTypeTimeout = "cheqd_timeout"
+
+	AttributeKeyDid        = "did"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAckError   = "error"
+)
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Gas charged per message and per tracked DID, same as feegrant.AllowedMsgAllowance does for allowed messages
+const feeAllowanceGasCostPerIteration = uint64(10)
+
+// MaxTrackedDids limits the number of DIDs operations are counted for, so that the allowance stays small
+// when fees are paid for any DID. Operations on new DIDs are rejected once the limit is reached.
+const MaxTrackedDids = 1000
+
+var _ feegrant.FeeAllowanceI = &IdentityFeeAllowance{}
+
+// Accept implements FeeAllowanceI.Accept. All messages must be identity operations on allowed DIDs.
+// The allowance is removed when the spend limit is used up.
+func (a *IdentityFeeAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.Expiration != nil && a.Expiration.Before(ctx.BlockTime()) {
+		return true, sdkerrors.Wrap(feegrant.ErrFeeLimitExpired, "identity fee allowance")
+	}
+
+	dids, err := a.acceptedDids(ctx, msgs)
+	if err != nil {
+		return false, err
+	}
+
+	var spendLimitLeft sdk.Coins
+	if !a.SpendLimit.Empty() {
+		var isNeg bool
+		spendLimitLeft, isNeg = a.SpendLimit.SafeSub(fee)
+		if isNeg {
+			return false, sdkerrors.Wrap(feegrant.ErrFeeLimitExceeded, "identity fee allowance")
+		}
+	}
+
+	if a.MaxOperationsPerDid > 0 {
+		for _, did := range dids {
+			if err := a.countOperation(ctx, did); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if !a.SpendLimit.Empty() {
+		a.SpendLimit = spendLimitLeft
+		return a.SpendLimit.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+// acceptedDids returns DIDs of the identity messages if all of them are allowed
+func (a *IdentityFeeAllowance) acceptedDids(ctx sdk.Context, msgs []sdk.Msg) ([]string, error) {
+	var dids []string
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(feeAllowanceGasCostPerIteration, "check identity msg")
+
+		var did string
+
+		switch msg := msg.(type) {
+		case *MsgCreateDid:
+			did = msg.GetPayload().GetId()
+		case *MsgUpdateDid:
+			did = msg.GetPayload().GetId()
+		default:
+			return nil, sdkerrors.Wrapf(feegrant.ErrMessageNotAllowed, "%s is not an identity message", sdk.MsgTypeURL(msg))
+		}
+
+		if len(a.Dids) > 0 && !utils.Contains(a.Dids, did) {
+			return nil, sdkerrors.Wrapf(feegrant.ErrMessageNotAllowed, "fees are not allowed for %s", did)
+		}
+
+		if len(a.Namespaces) > 0 {
+			_, namespace, _, err := utils.TrySplitDID(did)
+			if err != nil {
+				return nil, sdkerrors.Wrap(feegrant.ErrMessageNotAllowed, err.Error())
+			}
+
+			if !utils.Contains(a.Namespaces, namespace) {
+				return nil, sdkerrors.Wrapf(feegrant.ErrMessageNotAllowed, "fees are not allowed for namespace %s", namespace)
+			}
+		}
+
+		dids = append(dids, did)
+	}
+
+	return dids, nil
+}
+
+// countOperation increments the number of operations paid for the DID
+func (a *IdentityFeeAllowance) countOperation(ctx sdk.Context, did string) error {
+	for _, operations := range a.Operations {
+		ctx.GasMeter().ConsumeGas(feeAllowanceGasCostPerIteration, "check did operations")
+
+		if operations.Did != did {
+			continue
+		}
+
+		if operations.Count >= a.MaxOperationsPerDid {
+			return sdkerrors.Wrapf(feegrant.ErrFeeLimitExceeded, "operation limit reached for %s", did)
+		}
+
+		operations.Count++
+		return nil
+	}
+
+	if len(a.Operations) >= MaxTrackedDids {
+		return sdkerrors.Wrapf(feegrant.ErrFeeLimitExceeded, "operations are counted for %d DIDs already", MaxTrackedDids)
+	}
+
+	a.Operations = append(a.Operations, &DidOperationCount{Did: did, Count: 1})
+	return nil
+}
+
+// ValidateBasic implements FeeAllowanceI.ValidateBasic
+func (a *IdentityFeeAllowance) ValidateBasic() error {
+	if !a.SpendLimit.Empty() {
+		if !a.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit: %s", a.SpendLimit)
+		}
+
+		if !a.SpendLimit.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+		}
+	}
+
+	err := validation.ValidateStruct(a,
+		validation.Field(&a.Dids, IsUniqueStrList(), validation.Each(IsDID(nil))),
+		validation.Field(&a.Namespaces, IsUniqueStrList(), validation.Each(validation.Match(utils.DidNamespaceRegexp))),
+		validation.Field(&a.Operations, validation.When(a.MaxOperationsPerDid == 0, validation.Empty), validation.Length(0, MaxTrackedDids)),
+	)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/feegrant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IdentityFeeAllowance allows the grantee to use the granter's funds to pay fees
+// of MsgCreateDid and MsgUpdateDid transactions only.
+type IdentityFeeAllowance struct {
+	// Maximum amount of fees that can be paid. Unlimited if empty.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// Time after which the allowance can't be used. Never expires if not set.
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// DIDs fees are paid for. Any DID if empty.
+	Dids []string `protobuf:"bytes,3,rep,name=dids,proto3" json:"dids,omitempty"`
+	// Namespaces of DIDs fees are paid for. Any namespace if empty.
+	Namespaces []string `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Maximum number of operations per DID. Unlimited if 0.
+	MaxOperationsPerDid uint64 `protobuf:"varint,5,opt,name=max_operations_per_did,json=maxOperationsPerDid,proto3" json:"max_operations_per_did,omitempty"`
+	// Number of operations paid for each DID. Tracked only if max_operations_per_did is set.
+	Operations []*DidOperationCount `protobuf:"bytes,6,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (m *IdentityFeeAllowance) Reset()         { *m = IdentityFeeAllowance{} }
+func (m *IdentityFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*IdentityFeeAllowance) ProtoMessage()    {}
+func (*IdentityFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87d3de0f2678f7c8, []int{0}
+}
+func (m *IdentityFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentityFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentityFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentityFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentityFeeAllowance.Merge(m, src)
+}
+func (m *IdentityFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentityFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentityFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentityFeeAllowance proto.InternalMessageInfo
+
+func (m *IdentityFeeAllowance) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *IdentityFeeAllowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *IdentityFeeAllowance) GetDids() []string {
+	if m != nil {
+		return m.Dids
+	}
+	return nil
+}
+
+func (m *IdentityFeeAllowance) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *IdentityFeeAllowance) GetMaxOperationsPerDid() uint64 {
+	if m != nil {
+		return m.MaxOperationsPerDid
+	}
+	return 0
+}
+
+func (m *IdentityFeeAllowance) GetOperations() []*DidOperationCount {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// DidOperationCount is the number of operations paid for a DID
+type DidOperationCount struct {
+	Did   string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *DidOperationCount) Reset()         { *m = DidOperationCount{} }
+func (m *DidOperationCount) String() string { return proto.CompactTextString(m) }
+func (*DidOperationCount) ProtoMessage()    {}
+func (*DidOperationCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87d3de0f2678f7c8, []int{1}
+}
+func (m *DidOperationCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidOperationCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidOperationCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidOperationCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidOperationCount.Merge(m, src)
+}
+func (m *DidOperationCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidOperationCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidOperationCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidOperationCount proto.InternalMessageInfo
+
+func (m *DidOperationCount) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *DidOperationCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IdentityFeeAllowance)(nil), "cheqdid.cheqdnode.cheqd.v1.IdentityFeeAllowance")
+	proto.RegisterType((*DidOperationCount)(nil), "cheqdid.cheqdnode.cheqd.v1.DidOperationCount")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/feegrant.proto", fileDescriptor_87d3de0f2678f7c8) }
+
+var fileDescriptor_87d3de0f2678f7c8 = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x92, 0x4d, 0x9a, 0x2b, 0x24, 0x66, 0x2a, 0xc8, 0x7a, 0x48, 0xa3, 0x9d, 0xc2,
+	0xa1, 0x36, 0xdd, 0x6e, 0x70, 0x81, 0x76, 0x42, 0x9a, 0x04, 0x02, 0x45, 0x9c, 0xb8, 0x44, 0x4e,
+	0xfc, 0x2e, 0xb3, 0x48, 0xec, 0x10, 0xbb, 0xa5, 0xfb, 0x16, 0xe3, 0x6b, 0x70, 0xe6, 0x43, 0xec,
+	0x38, 0x71, 0xe2, 0xc4, 0x50, 0xfb, 0x45, 0x50, 0x9c, 0x6c, 0x44, 0x42, 0xbb, 0xc4, 0xef, 0xeb,
+	0xc7, 0xcf, 0x4f, 0xef, 0x9f, 0xa0, 0xa7, 0xd9, 0x39, 0x7c, 0xe1, 0x74, 0x35, 0xa3, 0x67, 0x00,
+	0x79, 0xcd, 0xa4, 0x21, 0x55, 0xad, 0x8c, 0xc2, 0x63, 0x2b, 0x08, 0x4e, 0xec, 0x29, 0x15, 0x87,
+	0x36, 0x22, 0xab, 0xd9, 0x78, 0x94, 0xab, 0x5c, 0xd9, 0x67, 0xb4, 0x89, 0x5a, 0xc7, 0x78, 0x92,
+	0x2b, 0x95, 0x17, 0x40, 0x6d, 0x96, 0x2e, 0xcf, 0xa8, 0x11, 0x25, 0x68, 0xc3, 0xca, 0xaa, 0x7b,
+	0x70, 0x90, 0x29, 0x5d, 0x2a, 0x9d, 0xb4, 0xce, 0x36, 0xe9, 0xa4, 0xa0, 0xcd, 0x68, 0xca, 0x34,
+	0xd0, 0xd5, 0x2c, 0x05, 0xc3, 0x66, 0x34, 0x53, 0x42, 0xb6, 0xfa, 0xe1, 0x37, 0x17, 0x8d, 0x4e,
+	0x39, 0x48, 0x23, 0xcc, 0xc5, 0x1b, 0x80, 0xd7, 0x45, 0xa1, 0xbe, 0x32, 0x99, 0x01, 0x2e, 0xd0,
+	0x50, 0x57, 0x20, 0x79, 0x52, 0x88, 0x52, 0x18, 0xdf, 0x09, 0xdd, 0x68, 0x78, 0x74, 0x40, 0x3a,
+	0x78, 0x83, 0x23, 0x1d, 0x8e, 0x2c, 0x94, 0x90, 0xf3, 0xe7, 0x57, 0xbf, 0x27, 0x83, 0xef, 0x37,
+	0x93, 0x28, 0x17, 0xe6, 0x7c, 0x99, 0x92, 0x4c, 0x95, 0x5d, 0x25, 0xdd, 0x31, 0xd5, 0xfc, 0x33,
+	0x35, 0x17, 0x15, 0x68, 0x6b, 0xd0, 0x31, 0xb2, 0xfc, 0xb7, 0x0d, 0x1e, 0xbf, 0x42, 0x08, 0xd6,
+	0x95, 0xa8, 0x99, 0x11, 0x4a, 0xfa, 0x0f, 0x42, 0x27, 0x1a, 0x1e, 0x8d, 0x49, 0xdb, 0x37, 0xb9,
+	0xed, 0x9b, 0x7c, 0xbc, 0xed, 0x7b, 0xee, 0x5d, 0xde, 0x4c, 0x9c, 0xb8, 0xe7, 0xc1, 0x18, 0x79,
+	0x5c, 0x70, 0xed, 0xbb, 0xa1, 0x1b, 0xed, 0xc5, 0x36, 0xc6, 0x01, 0x42, 0x92, 0x95, 0xa0, 0x2b,
+	0x96, 0x81, 0xf6, 0x3d, 0xab, 0xf4, 0x6e, 0xf0, 0x31, 0x7a, 0x52, 0xb2, 0x75, 0xa2, 0x2a, 0x68,
+	0x21, 0x3a, 0xa9, 0xa0, 0x4e, 0xb8, 0xe0, 0xfe, 0x4e, 0xe8, 0x44, 0x5e, 0xfc, 0xb8, 0x64, 0xeb,
+	0xf7, 0x77, 0xe2, 0x07, 0xa8, 0x4f, 0x04, 0xc7, 0xef, 0x10, 0xfa, 0x67, 0xf0, 0x77, 0xed, 0x5c,
+	0xa6, 0xe4, 0xfe, 0xa5, 0x92, 0x13, 0xc1, 0xef, 0x20, 0x0b, 0xb5, 0x94, 0x26, 0xee, 0x01, 0x5e,
+	0xec, 0xff, 0xfc, 0x31, 0x7d, 0xd8, 0x9f, 0xfc, 0xe9, 0xe1, 0x4b, 0xb4, 0xff, 0x9f, 0x07, 0x3f,
+	0x42, 0x6e, 0x53, 0x98, 0x13, 0x3a, 0xd1, 0x5e, 0xdc, 0x84, 0x78, 0x84, 0x76, 0xb2, 0x46, 0xb2,
+	0xe3, 0xf2, 0xe2, 0x36, 0x99, 0x2f, 0xae, 0x36, 0x81, 0x73, 0xbd, 0x09, 0x9c, 0x3f, 0x9b, 0xc0,
+	0xb9, 0xdc, 0x06, 0x83, 0xeb, 0x6d, 0x30, 0xf8, 0xb5, 0x0d, 0x06, 0x9f, 0x9e, 0xf5, 0x37, 0x63,
+	0x7f, 0x4e, 0xfb, 0x9d, 0x36, 0xd5, 0xd2, 0x75, 0x77, 0x65, 0x17, 0x94, 0xee, 0xda, 0x91, 0x1f,
+	0xff, 0x1d, 0x00, 0x61, 0xe3, 0x57, 0xec, 0xc5, 0x02, 0x00, 0x00,
+}
+
+func (m *IdentityFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentityFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentityFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxOperationsPerDid != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxOperationsPerDid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Dids) > 0 {
+		for iNdEx := len(m.Dids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dids[iNdEx])
+			copy(dAtA[i:], m.Dids[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Dids[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFeegrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidOperationCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidOperationCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidOperationCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IdentityFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Dids) > 0 {
+		for _, s := range m.Dids {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.MaxOperationsPerDid != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxOperationsPerDid))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DidOperationCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovFeegrant(uint64(m.Count))
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IdentityFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentityFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentityFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dids = append(m.Dids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOperationsPerDid", wireType)
+			}
+			m.MaxOperationsPerDid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOperationsPerDid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &DidOperationCount{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidOperationCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidOperationCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidOperationCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeegrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeegrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeegrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeegrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeegrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeegrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeegrant = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityFeeAllowanceValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		struct_  *IdentityFeeAllowance
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "Valid: no limits",
+			struct_: &IdentityFeeAllowance{},
+			isValid: true,
+		},
+		{
+			name: "Valid: all limits",
+			struct_: &IdentityFeeAllowance{
+				SpendLimit:          sdk.NewCoins(sdk.NewInt64Coin("ncheq", 100)),
+				Dids:                []string{"did:cheqd:testnet:123456789abcdefg"},
+				Namespaces:          []string{"testnet", ""},
+				MaxOperationsPerDid: 2,
+				Operations:          []*DidOperationCount{{Did: "did:cheqd:testnet:123456789abcdefg", Count: 1}},
+			},
+			isValid: true,
+		},
+		{
+			name:     "Not valid: zero spend limit",
+			struct_:  &IdentityFeeAllowance{SpendLimit: sdk.Coins{sdk.NewInt64Coin("ncheq", 0)}},
+			isValid:  false,
+			errorMsg: "spend limit: 0ncheq: invalid coins",
+		},
+		{
+			name:     "Not valid: not a DID",
+			struct_:  &IdentityFeeAllowance{Dids: []string{"did:cheqd:testnet:123456789abcdefg#key1"}},
+			isValid:  false,
+			errorMsg: "dids: (0: unable to split did into method, namespace and id: did method-specific id: invalid character '#' at position 24.).: invalid request",
+		},
+		{
+			name:     "Not valid: namespace duplicates",
+			struct_:  &IdentityFeeAllowance{Namespaces: []string{"testnet", "testnet"}},
+			isValid:  false,
+			errorMsg: "namespaces: there should be no duplicates.: invalid request",
+		},
+		{
+			name:     "Not valid: operations are counted without limit",
+			struct_:  &IdentityFeeAllowance{Operations: []*DidOperationCount{{Did: "did:cheqd:testnet:123456789abcdefg", Count: 1}}},
+			isValid:  false,
+			errorMsg: "operations: must be blank.: invalid request",
+		},
+		{
+			name:     "Not valid: too many DIDs are tracked",
+			struct_:  &IdentityFeeAllowance{MaxOperationsPerDid: 1, Operations: make([]*DidOperationCount, MaxTrackedDids+1)},
+			isValid:  false,
+			errorMsg: "operations: the length must be no more than 1000.: invalid request",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.struct_.ValidateBasic()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestIdentityFeeAllowanceAccept(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+
+	alice := "did:cheqd:testnet:aaaaaaaaaaaaaaaa"
+	bob := "did:cheqd:mainnet:bbbbbbbbbbbbbbbb"
+
+	createAlice := &MsgCreateDid{Payload: &MsgCreateDidPayload{Id: alice}}
+	updateAlice := &MsgUpdateDid{Payload: &MsgUpdateDidPayload{Id: alice}}
+	updateBob := &MsgUpdateDid{Payload: &MsgUpdateDidPayload{Id: bob}}
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ncheq", 10))
+
+	var trackedDids []*DidOperationCount
+	for i := 0; i < MaxTrackedDids-1; i++ {
+		trackedDids = append(trackedDids, &DidOperationCount{Did: fmt.Sprintf("did:cheqd:testnet:%016d", i), Count: 1})
+	}
+
+	cases := []struct {
+		name      string
+		allowance *IdentityFeeAllowance
+		msgs      []sdk.Msg
+		remove    bool
+		errorMsg  string
+		expected  *IdentityFeeAllowance
+	}{
+		{
+			name:      "Valid: no limits",
+			allowance: &IdentityFeeAllowance{},
+			msgs:      []sdk.Msg{createAlice, updateBob},
+			expected:  &IdentityFeeAllowance{},
+		},
+		{
+			name:      "Valid: spend limit is decreased",
+			allowance: &IdentityFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 25))},
+			msgs:      []sdk.Msg{updateAlice},
+			expected:  &IdentityFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 15))},
+		},
+		{
+			name:      "Valid: spend limit is used up",
+			allowance: &IdentityFeeAllowance{SpendLimit: fee},
+			msgs:      []sdk.Msg{updateAlice},
+			remove:    true,
+		},
+		{
+			name:      "Valid: DID and namespace are allowed",
+			allowance: &IdentityFeeAllowance{Dids: []string{alice, bob}, Namespaces: []string{"testnet"}},
+			msgs:      []sdk.Msg{createAlice, updateAlice},
+			expected:  &IdentityFeeAllowance{Dids: []string{alice, bob}, Namespaces: []string{"testnet"}},
+		},
+		{
+			name: "Valid: operations are counted",
+			allowance: &IdentityFeeAllowance{
+				MaxOperationsPerDid: 3,
+				Operations:          []*DidOperationCount{{Did: alice, Count: 1}},
+			},
+			msgs: []sdk.Msg{createAlice, updateAlice, updateBob},
+			expected: &IdentityFeeAllowance{
+				MaxOperationsPerDid: 3,
+				Operations:          []*DidOperationCount{{Did: alice, Count: 3}, {Did: bob, Count: 1}},
+			},
+		},
+		{
+			name:      "Not valid: expired",
+			allowance: &IdentityFeeAllowance{Expiration: &past},
+			msgs:      []sdk.Msg{updateAlice},
+			remove:    true,
+			errorMsg:  "identity fee allowance: fee allowance expired",
+		},
+		{
+			name:      "Not valid: spend limit exceeded",
+			allowance: &IdentityFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("ncheq", 5))},
+			msgs:      []sdk.Msg{updateAlice},
+			errorMsg:  "identity fee allowance: fee limit exceeded",
+		},
+		{
+			name:      "Not valid: not an identity message",
+			allowance: &IdentityFeeAllowance{},
+			msgs:      []sdk.Msg{updateAlice, &banktypes.MsgSend{}},
+			errorMsg:  "/cosmos.bank.v1beta1.MsgSend is not an identity message: message not allowed",
+		},
+		{
+			name:      "Not valid: DID is not allowed",
+			allowance: &IdentityFeeAllowance{Dids: []string{alice}},
+			msgs:      []sdk.Msg{updateBob},
+			errorMsg:  "fees are not allowed for did:cheqd:mainnet:bbbbbbbbbbbbbbbb: message not allowed",
+		},
+		{
+			name:      "Not valid: namespace is not allowed",
+			allowance: &IdentityFeeAllowance{Namespaces: []string{"testnet"}},
+			msgs:      []sdk.Msg{updateAlice, updateBob},
+			errorMsg:  "fees are not allowed for namespace mainnet: message not allowed",
+		},
+		{
+			name: "Not valid: operation limit reached",
+			allowance: &IdentityFeeAllowance{
+				MaxOperationsPerDid: 2,
+				Operations:          []*DidOperationCount{{Did: alice, Count: 1}},
+			},
+			msgs:     []sdk.Msg{createAlice, updateAlice},
+			errorMsg: "operation limit reached for did:cheqd:testnet:aaaaaaaaaaaaaaaa: fee limit exceeded",
+		},
+		{
+			name: "Not valid: tracked DID limit reached",
+			allowance: &IdentityFeeAllowance{
+				MaxOperationsPerDid: 2,
+				Operations:          append(trackedDids, &DidOperationCount{Did: bob, Count: 1}),
+			},
+			msgs:     []sdk.Msg{updateBob, updateAlice},
+			errorMsg: "operations are counted for 1000 DIDs already: fee limit exceeded",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(now).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			remove, err := tc.allowance.Accept(ctx, fee, tc.msgs)
+			require.Equal(t, tc.remove, remove)
+
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+				if !tc.remove {
+					require.Equal(t, tc.expected, tc.allowance)
+				}
+			} else {
+				require.EqualError(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
@@ -1,22 +1,96 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 const DefaultDidNamespace = "testnet"
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+
 	return &GenesisState{
 		DidList:      []*StateValue{},
 		DidNamespace: DefaultDidNamespace,
+		PortId:       PortID,
+		Params:       &params,
 	}
 }
 
+var _ types.UnpackInterfacesMessage = &GenesisState{}
+
+func (gs *GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, elem := range gs.DidList {
+		if err := elem.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. DID Docs are checked the same way the msg server checks new DIDs.
 func (gs GenesisState) Validate() error {
+	if err := gs.ValidateBasic(); err != nil {
+		return err
+	}
+
+	var allowedNamespaces []string
+	if gs.DidNamespace != "" {
+		allowedNamespaces = []string{gs.DidNamespace}
+	}
+
+	didIdMap := make(map[string]bool)
+	var dids []*Did
+
+	for _, elem := range gs.DidList {
+		did, err := ValidateGenesisDid(elem, allowedNamespaces, gs.GetParamsOrDefault())
+		if err != nil {
+			return err
+		}
+
+		didIdMap[did.Id] = true
+		dids = append(dids, did)
+	}
+
+	// Controllers must exist, as the ledger checks when DIDs are written
+	for _, did := range dids {
+		for _, controller := range did.AllControllerDids() {
+			if !didIdMap[controller] {
+				return ErrDidDocNotFound.Wrapf("controller %s of %s", controller, did.Id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that the genesis state can be imported. DID Docs aren't checked against
+// the current rules, as the state exported from a chain may contain documents written under older ones.
+func (gs GenesisState) ValidateBasic() error {
+	// Port id is optional for genesis files created before IBC support was added
+	if gs.PortId != "" {
+		if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+			return err
+		}
+	}
+
+	// Params are optional for genesis files created before params were added
+	if gs.Params != nil {
+		if err := gs.Params.Validate(); err != nil {
+			return err
+		}
+	}
+
 	didIdMap := make(map[string]bool)
 
 	for _, elem := range gs.DidList {
@@ -25,8 +99,12 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 
+		if elem.Metadata == nil {
+			return fmt.Errorf("metadata is required for did: %s", did.Id)
+		}
+
 		if _, ok := didIdMap[did.Id]; ok {
-			return fmt.Errorf("duplicated id for did")
+			return fmt.Errorf("duplicated id for did: %s", did.Id)
 		}
 
 		didIdMap[did.Id] = true
@@ -34,3 +112,143 @@ func (gs GenesisState) Validate() error {
 
 	return nil
 }
+
+// GetParamsOrDefault returns the params of the genesis state. Default params are returned for
+// genesis files created before params were added, as InitGenesis keeps them in that case.
+func (gs GenesisState) GetParamsOrDefault() Params {
+	if gs.Params == nil {
+		return DefaultParams()
+	}
+
+	return *gs.Params
+}
+
+// ValidateGenesisDid runs the checks the msg server runs for new DIDs: document and namespace checks,
+// limits, allowed contexts and the unique id format. There are no signatures in genesis, so self-certifying
+// unique ids only have to be derived from one of the verification methods of the DID Doc.
+func ValidateGenesisDid(stateValue *StateValue, allowedNamespaces []string, params Params) (*Did, error) {
+	did, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return nil, err
+	}
+
+	if stateValue.Metadata == nil {
+		return nil, fmt.Errorf("metadata is required for did: %s", did.Id)
+	}
+
+	if err := did.Validate(allowedNamespaces); err != nil {
+		return nil, WithDetails(ErrNamespaceValidation.Wrapf("did: %s, err: %s", did.Id, err.Error()), ValidationErrorDetails(err))
+	}
+
+	if err := params.ValidateDidLimits(*did); err != nil {
+		return nil, ErrDidDocLimitExceeded.Wrapf("did: %s, err: %s", did.Id, err.Error())
+	}
+
+	if err := params.ValidateDidContext(*did); err != nil {
+		return nil, ErrContextNotAllowed.Wrapf("did: %s, err: %s", did.Id, err.Error())
+	}
+
+	if err := validateGenesisUniqueId(*did, params); err != nil {
+		return nil, err
+	}
+
+	return did, nil
+}
+
+// validateGenesisUniqueId checks that the unique id is in one of the formats allowed by governance
+func validateGenesisUniqueId(did Did, params Params) error {
+	_, namespace, uniqueId, err := utils.TrySplitDID(did.Id)
+	if err != nil {
+		return ErrInvalidUniqueId.Wrap(err.Error())
+	}
+
+	for _, format := range params.UniqueIdFormats {
+		if ValidateUniqueIdFormat(uniqueId, format) != nil {
+			continue
+		}
+
+		if format != UniqueIdFormatSelfCertifying {
+			return nil
+		}
+
+		for _, vm := range did.GetAllVerificationMethods() {
+			if derived, err := vm.SelfCertifyingUniqueId(); err == nil && derived == uniqueId {
+				return nil
+			}
+		}
+	}
+
+	return ErrInvalidUniqueId.Wrapf("did: %s, unique id: %s, allowed formats in namespace %s: %v", did.Id, uniqueId, namespace, params.UniqueIdFormats)
+}
+
+// Empty DID list in JSON encoded genesis state. It's replaced with DIDs by GenesisJSONWriter.
+var emptyGenesisDidList = []byte(`"didList":[]`)
+
+// GenesisJSONWriter writes JSON encoded genesis state adding DIDs one by one,
+// so the DID list never has to be kept in memory as a whole.
+type GenesisJSONWriter struct {
+	cdc    codec.JSONCodec
+	writer io.Writer
+	suffix []byte
+	count  int
+}
+
+// NewGenesisJSONWriter writes the genesis state up to the end of its DID list. DIDs of the state are written first.
+// More DIDs are added with WriteDid, and Close finishes the document.
+func NewGenesisJSONWriter(cdc codec.JSONCodec, writer io.Writer, genState GenesisState) (*GenesisJSONWriter, error) {
+	didList := genState.DidList
+	genState.DidList = nil
+
+	bz, err := cdc.MarshalJSON(&genState)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := bytes.Split(bz, emptyGenesisDidList)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected encoding of genesis state: %s", bz)
+	}
+
+	if _, err := writer.Write(append(parts[0], emptyGenesisDidList[:len(emptyGenesisDidList)-1]...)); err != nil {
+		return nil, err
+	}
+
+	gw := &GenesisJSONWriter{
+		cdc:    cdc,
+		writer: writer,
+		suffix: append([]byte{']'}, parts[1]...),
+	}
+
+	for _, stateValue := range didList {
+		if err := gw.WriteDid(stateValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return gw, nil
+}
+
+// WriteDid appends a DID to the DID list
+func (gw *GenesisJSONWriter) WriteDid(stateValue *StateValue) error {
+	bz, err := gw.cdc.MarshalJSON(stateValue)
+	if err != nil {
+		return err
+	}
+
+	if gw.count > 0 {
+		bz = append([]byte{','}, bz...)
+	}
+
+	if _, err := gw.writer.Write(bz); err != nil {
+		return err
+	}
+
+	gw.count++
+	return nil
+}
+
+// Close writes the rest of the genesis state after the DID list
+func (gw *GenesisJSONWriter) Close() error {
+	_, err := gw.writer.Write(gw.suffix)
+	return err
+}
@@ -26,6 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	DidNamespace string        `protobuf:"bytes,1,opt,name=did_namespace,json=didNamespace,proto3" json:"did_namespace,omitempty"`
 	DidList      []*StateValue `protobuf:"bytes,2,rep,name=didList,proto3" json:"didList,omitempty"`
+	PortId       string        `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Params       *Params       `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,6 +77,20 @@ func (m *GenesisState) GetDidList() []*StateValue {
 	return nil
 }
 
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cheqdid.cheqdnode.cheqd.v1.GenesisState")
 }
@@ -82,21 +98,24 @@ func init() {
 func init() { proto.RegisterFile("cheqd/v1/genesis.proto", fileDescriptor_85a78c6000d41e7d) }
 
 var fileDescriptor_85a78c6000d41e7d = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
+	// 265 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x2d,
 	0x4c, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0x92, 0x02, 0x8b, 0x67, 0xa6, 0xe8, 0x81, 0xe9, 0xbc, 0xfc, 0x94, 0x54, 0x08,
 	0x4b, 0xaf, 0xcc, 0x50, 0x4a, 0x12, 0xae, 0xa7, 0xb8, 0x24, 0xb1, 0x24, 0x35, 0x2c, 0x31, 0xa7,
-	0x34, 0x15, 0xa2, 0x4d, 0xa9, 0x94, 0x8b, 0xc7, 0x1d, 0x62, 0x4e, 0x30, 0x48, 0x4a, 0x48, 0x99,
-	0x8b, 0x37, 0x25, 0x33, 0x25, 0x3e, 0x2f, 0x31, 0x37, 0xb5, 0xb8, 0x20, 0x31, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x27, 0x25, 0x33, 0xc5, 0x0f, 0x26, 0x26, 0xe4, 0xc0, 0xc5,
-	0x9e, 0x92, 0x99, 0xe2, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa6,
-	0x87, 0xdb, 0x76, 0xbd, 0x60, 0xb8, 0x9d, 0x41, 0x30, 0x6d, 0x4e, 0xce, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0x0f, 0x71, 0x36, 0x98, 0xd4, 0x05, 0x99, 0xa9, 0x5f, 0x01, 0x15, 0x2a, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x99, 0xa3, 0x00,
-	0xd6, 0x13, 0x01, 0x00, 0x00,
+	0x34, 0x15, 0xa2, 0x4d, 0x4a, 0x14, 0x2e, 0x55, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x4d, 0xe9,
+	0x14, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xfc, 0x60, 0x90, 0x16, 0x21, 0x65, 0x2e, 0xde, 0x94, 0xcc,
+	0x94, 0xf8, 0xbc, 0xc4, 0xdc, 0xd4, 0xe2, 0x82, 0xc4, 0xe4, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
+	0xce, 0x20, 0x9e, 0x94, 0xcc, 0x14, 0x3f, 0x98, 0x98, 0x90, 0x03, 0x17, 0x7b, 0x4a, 0x66, 0x8a,
+	0x4f, 0x66, 0x71, 0x89, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x9a, 0x1e, 0x6e, 0x57, 0xe9,
+	0x05, 0xc3, 0xdd, 0x12, 0x04, 0xd3, 0x26, 0x24, 0xce, 0xc5, 0x5e, 0x90, 0x5f, 0x54, 0x12, 0x9f,
+	0x99, 0x22, 0xc1, 0x0c, 0xb6, 0x80, 0x0d, 0xc4, 0xf5, 0x4c, 0x11, 0xb2, 0xe2, 0x62, 0x83, 0x38,
+	0x50, 0x82, 0x45, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x09, 0x9f, 0xc9, 0x01, 0x60, 0x95, 0x41, 0x50,
+	0x1d, 0x4e, 0xce, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
+	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x99, 0x9e,
+	0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x09, 0x08, 0x30, 0xa9, 0x0b, 0x32,
+	0x4e, 0xbf, 0x02, 0x2a, 0x54, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x18, 0x63, 0xc0,
+	0x00, 0xc1, 0x95, 0x40, 0x58, 0x80, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +138,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DidList) > 0 {
 		for iNdEx := len(m.DidList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -170,6 +208,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -274,6 +320,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,267 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func genesisDid(t *testing.T, did *Did) *StateValue {
+	stateValue, err := NewStateValue(did, &Metadata{Created: "2021-01-01T00:00:00Z", VersionId: "version"})
+	require.NoError(t, err)
+
+	return &stateValue
+}
+
+func genesisTestDid(id string, controller ...string) *Did {
+	keyId := fmt.Sprintf("%s#key-1", id)
+
+	return &Did{
+		Id:         id,
+		Controller: controller,
+		VerificationMethod: []*VerificationMethod{
+			{
+				Id:                 keyId,
+				Type:               Ed25519VerificationKey2020,
+				Controller:         id,
+				PublicKeyMultibase: ValidEd25519PubKey,
+			},
+		},
+		Authentication: VerificationMethodReferences(keyId),
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	withoutMetadata := genesisDid(t, genesisTestDid(ValidTestDID))
+	withoutMetadata.Metadata = nil
+
+	invalidKey := genesisTestDid(ValidTestDID)
+	invalidKey.VerificationMethod[0].PublicKeyMultibase = NotValidEd25519PubKey
+
+	tooManyServices := genesisTestDid(ValidTestDID)
+	for i := 0; i <= int(DefaultMaxServices); i++ {
+		tooManyServices.Service = append(tooManyServices.Service, &Service{
+			Id:              fmt.Sprintf("%s#service-%d", ValidTestDID, i),
+			Type:            "DIDCommMessaging",
+			ServiceEndpoint: "endpoint",
+		})
+	}
+
+	notAllowedContext := genesisTestDid(ValidTestDID)
+	notAllowedContext.Context = []string{"https://example.com/context"}
+
+	cases := []struct {
+		name     string
+		didList  []*StateValue
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "Valid: DIDs with controllers",
+			didList: []*StateValue{genesisDid(t, genesisTestDid(ValidTestDID)), genesisDid(t, genesisTestDid(ValidTestDID2, ValidTestDID))},
+			isValid: true,
+		},
+		{
+			name:     "Not valid: duplicated DID",
+			didList:  []*StateValue{genesisDid(t, genesisTestDid(ValidTestDID)), genesisDid(t, genesisTestDid(ValidTestDID))},
+			isValid:  false,
+			errorMsg: "duplicated id for did: did:cheqd:testnet:123456789abcdefg",
+		},
+		{
+			name:     "Not valid: controller is not in genesis",
+			didList:  []*StateValue{genesisDid(t, genesisTestDid(ValidTestDID2, ValidTestDID))},
+			isValid:  false,
+			errorMsg: "controller did:cheqd:testnet:123456789abcdefg of did:cheqd:testnet:gfedcba987654321: DID Doc not found",
+		},
+		{
+			name:     "Not valid: DID from another namespace",
+			didList:  []*StateValue{genesisDid(t, genesisTestDid("did:cheqd:mainnet:123456789abcdefg"))},
+			isValid:  false,
+			errorMsg: "did namespace must be one of: testnet",
+		},
+		{
+			name:     "Not valid: invalid DID Doc",
+			didList:  []*StateValue{genesisDid(t, invalidKey)},
+			isValid:  false,
+			errorMsg: "verification_method: (0: (public_key_multibase: ed25519: bad public key length: 18.).).",
+		},
+		{
+			name:     "Not valid: DID Doc exceeds limits",
+			didList:  []*StateValue{genesisDid(t, tooManyServices)},
+			isValid:  false,
+			errorMsg: "DID Doc exceeds limits",
+		},
+		{
+			name:     "Not valid: context is not allowed",
+			didList:  []*StateValue{genesisDid(t, notAllowedContext)},
+			isValid:  false,
+			errorMsg: "context is not allowed",
+		},
+		{
+			name:     "Not valid: unique id format is not allowed",
+			didList:  []*StateValue{genesisDid(t, genesisTestDid("did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130"))},
+			isValid:  false,
+			errorMsg: "allowed formats in namespace testnet: [base58]",
+		},
+		{
+			name:     "Not valid: metadata is missing",
+			didList:  []*StateValue{withoutMetadata},
+			isValid:  false,
+			errorMsg: "metadata is required for did: did:cheqd:testnet:123456789abcdefg",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := DefaultGenesis()
+			genesis.DidList = tc.didList
+
+			err := genesis.Validate()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestGenesisStateUnpackInterfaces(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	genesis := DefaultGenesis()
+	genesis.DidList = []*StateValue{genesisDid(t, genesisTestDid(ValidTestDID))}
+
+	bz, err := cdc.MarshalJSON(genesis)
+	require.NoError(t, err)
+
+	// DIDs decoded from a genesis file must be unpacked to be validated
+	var decoded GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	require.NoError(t, decoded.Validate())
+
+	did, err := decoded.DidList[0].UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, ValidTestDID, did.Id)
+}
+
+func TestGenesisStateValidateBasic(t *testing.T) {
+	// Written under older rules: no longer passes the checks for new DIDs
+	legacyDid := genesisTestDid(ValidTestDID)
+	legacyDid.VerificationMethod[0].PublicKeyMultibase = NotValidEd25519PubKey
+
+	withoutMetadata := genesisDid(t, genesisTestDid(ValidTestDID))
+	withoutMetadata.Metadata = nil
+
+	cases := []struct {
+		name     string
+		didList  []*StateValue
+		portId   string
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "Valid: DID Doc that doesn't pass the current rules",
+			didList: []*StateValue{genesisDid(t, legacyDid)},
+			isValid: true,
+		},
+		{
+			name:    "Valid: controller is not in genesis",
+			didList: []*StateValue{genesisDid(t, genesisTestDid(ValidTestDID2, ValidTestDID))},
+			isValid: true,
+		},
+		{
+			name:     "Not valid: duplicated DID",
+			didList:  []*StateValue{genesisDid(t, legacyDid), genesisDid(t, legacyDid)},
+			isValid:  false,
+			errorMsg: "duplicated id for did: did:cheqd:testnet:123456789abcdefg",
+		},
+		{
+			name:     "Not valid: metadata is missing",
+			didList:  []*StateValue{withoutMetadata},
+			isValid:  false,
+			errorMsg: "metadata is required for did: did:cheqd:testnet:123456789abcdefg",
+		},
+		{
+			name:     "Not valid: port id",
+			portId:   "a",
+			isValid:  false,
+			errorMsg: "identifier a has invalid length",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := DefaultGenesis()
+			genesis.DidList = tc.didList
+			if tc.portId != "" {
+				genesis.PortId = tc.portId
+			}
+
+			err := genesis.ValidateBasic()
+
+			if tc.isValid {
+				require.NoError(t, err)
+				require.Error(t, genesis.Validate())
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestGenesisJSONWriter(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	first := genesisDid(t, genesisTestDid(ValidTestDID))
+	second := genesisDid(t, genesisTestDid(ValidTestDID2, ValidTestDID))
+
+	cases := []struct {
+		name         string
+		stateDids    []*StateValue
+		addedDids    []*StateValue
+		expectedDids []*StateValue
+	}{
+		{name: "Valid: no DIDs"},
+		{name: "Valid: DIDs of the state", stateDids: []*StateValue{first, second}, expectedDids: []*StateValue{first, second}},
+		{name: "Valid: added DIDs", addedDids: []*StateValue{first, second}, expectedDids: []*StateValue{first, second}},
+		{name: "Valid: added DIDs follow DIDs of the state", stateDids: []*StateValue{second}, addedDids: []*StateValue{first}, expectedDids: []*StateValue{second, first}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := DefaultGenesis()
+			genesis.DidList = tc.stateDids
+
+			var buf bytes.Buffer
+			gw, err := NewGenesisJSONWriter(cdc, &buf, *genesis)
+			require.NoError(t, err)
+
+			for _, stateValue := range tc.addedDids {
+				require.NoError(t, gw.WriteDid(stateValue))
+			}
+			require.NoError(t, gw.Close())
+
+			// The output is the same as if the whole state was marshalled at once
+			genesis.DidList = tc.expectedDids
+			expected, err := cdc.MarshalJSON(genesis)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), buf.String())
+
+			var decoded GenesisState
+			require.NoError(t, cdc.UnmarshalJSON(buf.Bytes(), &decoded))
+			require.Len(t, decoded.DidList, len(tc.expectedDids))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DidHooks are called by the cheqd keeper after DID Docs are changed, so that other modules can react
+// to identity changes. An error returned by a hook aborts the transaction.
+type DidHooks interface {
+	AfterDidCreated(ctx sdk.Context, did *Did, metadata *Metadata) error
+	AfterDidUpdated(ctx sdk.Context, previous *Did, updated *Did, metadata *Metadata) error
+}
+
+var _ DidHooks = MultiDidHooks{}
+
+// MultiDidHooks combines hooks of several modules. Hooks are called in order, the first error stops the chain.
+type MultiDidHooks []DidHooks
+
+func NewMultiDidHooks(hooks ...DidHooks) MultiDidHooks {
+	return hooks
+}
+
+func (h MultiDidHooks) AfterDidCreated(ctx sdk.Context, did *Did, metadata *Metadata) error {
+	for _, hook := range h {
+		if err := hook.AfterDidCreated(ctx, did, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiDidHooks) AfterDidUpdated(ctx sdk.Context, previous *Did, updated *Did, metadata *Metadata) error {
+	for _, hook := range h {
+		if err := hook.AfterDidUpdated(ctx, previous, updated, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
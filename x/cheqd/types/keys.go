@@ -14,6 +14,12 @@ const (
 	QuerierRoute = ModuleName
 
 	DidMethod = ModuleName
+
+	// PortID is the default port id that the module binds to
+	PortID = ModuleName
+
+	// Version defines the current version of the cheqd IBC application
+	Version = "cheqd-1"
 )
 
 func KeyPrefix(p string) []byte {
@@ -24,4 +30,15 @@ const (
 	DidKey          = "did:"
 	DidCountKey     = "did-count:"
 	DidNamespaceKey = "did-namespace:"
+	PortKey         = "port:"
 )
+
+// GetDidStoreKey returns the key of a DID Doc in the module store
+func GetDidStoreKey(id string) []byte {
+	return append(KeyPrefix(DidKey), id...)
+}
+
+// GetDidCountStoreKey returns the key of the DID Docs count in the module store
+func GetDidCountStoreKey() []byte {
+	return append(KeyPrefix(DidCountKey), DidCountKey...)
+}
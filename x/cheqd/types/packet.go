@@ -0,0 +1,50 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// PacketCdc is used to encode packets and acknowledgements as JSON so that counterparty
+// chains don't have to implement gogoproto binary encoding.
+var PacketCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
+func NewResolveDidPacketData(id string) CheqdPacketData {
+	return CheqdPacketData{
+		Packet: &CheqdPacketData_ResolveDid{
+			ResolveDid: &ResolveDidPacketData{Id: id},
+		},
+	}
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data
+func (p CheqdPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(PacketCdc.MustMarshalJSON(&p))
+}
+
+// GetBytes returns the sorted JSON encoding of the acknowledgement result
+func (a ResolveDidPacketAck) GetBytes() []byte {
+	return sdk.MustSortJSON(PacketCdc.MustMarshalJSON(&a))
+}
+
+// Validation
+
+func (p CheqdPacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *CheqdPacketData_ResolveDid:
+		return packet.ResolveDid.Validate()
+	default:
+		return errors.New("unknown packet type")
+	}
+}
+
+func (p ResolveDidPacketData) Validate() error {
+	// Namespaces are not known on the sender side, so only the generic DID format is checked
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Id, validation.Required, IsDID(nil)),
+	)
+}
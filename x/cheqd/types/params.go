@@ -0,0 +1,262 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Default parameter values
+const (
+	DefaultDidDocByteCost         uint64 = 10
+	DefaultEd25519SigVerifyCost   uint64 = 590
+	DefaultEcdsaSigVerifyCost     uint64 = 1000
+	DefaultRsaSigVerifyCost       uint64 = 2000
+	DefaultMaxVerificationMethods uint64 = 64
+	DefaultMaxServices            uint64 = 64
+	DefaultMaxControllers         uint64 = 32
+	DefaultAuthenticationFallback        = false
+)
+
+// DefaultUniqueIdFormats keep unique ids of new DIDs base58 strings of 16 or 32 symbols.
+// Formats aren't set per namespace: new DIDs are only accepted in the DID namespace of the chain.
+var DefaultUniqueIdFormats = []string{
+	UniqueIdFormatBase58,
+}
+
+// DefaultAllowedContexts are contexts of the supported verification method types
+var DefaultAllowedContexts = []string{
+	"https://w3id.org/security/suites/ed25519-2020/v1",
+	"https://w3id.org/security/suites/secp256k1-2019/v1",
+	"https://w3id.org/security/suites/jws-2020/v1",
+	"https://w3id.org/security/suites/x25519-2020/v1",
+	"https://w3id.org/security/suites/x25519-2019/v1",
+}
+
+// Parameter keys
+var (
+	KeyDidDocByteCost         = []byte("DidDocByteCost")
+	KeyEd25519SigVerifyCost   = []byte("Ed25519SigVerifyCost")
+	KeyEcdsaSigVerifyCost     = []byte("EcdsaSigVerifyCost")
+	KeyRsaSigVerifyCost       = []byte("RsaSigVerifyCost")
+	KeyMaxVerificationMethods = []byte("MaxVerificationMethods")
+	KeyMaxServices            = []byte("MaxServices")
+	KeyMaxControllers         = []byte("MaxControllers")
+	KeyAuthenticationFallback = []byte("AuthenticationFallback")
+	KeyAllowedContexts        = []byte("AllowedContexts")
+	KeyUniqueIdFormats        = []byte("UniqueIdFormats")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for cheqd module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of cheqd module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDidDocByteCost, &p.DidDocByteCost, validateUint64),
+		paramtypes.NewParamSetPair(KeyEd25519SigVerifyCost, &p.Ed25519SigVerifyCost, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyEcdsaSigVerifyCost, &p.EcdsaSigVerifyCost, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyRsaSigVerifyCost, &p.RsaSigVerifyCost, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMaxVerificationMethods, &p.MaxVerificationMethods, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMaxServices, &p.MaxServices, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMaxControllers, &p.MaxControllers, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyAuthenticationFallback, &p.AuthenticationFallback, validateBool),
+		paramtypes.NewParamSetPair(KeyAllowedContexts, &p.AllowedContexts, validateContexts),
+		paramtypes.NewParamSetPair(KeyUniqueIdFormats, &p.UniqueIdFormats, validateUniqueIdFormats),
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		DidDocByteCost:         DefaultDidDocByteCost,
+		Ed25519SigVerifyCost:   DefaultEd25519SigVerifyCost,
+		EcdsaSigVerifyCost:     DefaultEcdsaSigVerifyCost,
+		RsaSigVerifyCost:       DefaultRsaSigVerifyCost,
+		MaxVerificationMethods: DefaultMaxVerificationMethods,
+		MaxServices:            DefaultMaxServices,
+		MaxControllers:         DefaultMaxControllers,
+		AuthenticationFallback: DefaultAuthenticationFallback,
+		AllowedContexts:        append([]string{}, DefaultAllowedContexts...),
+		UniqueIdFormats:        append([]string{}, DefaultUniqueIdFormats...),
+	}
+}
+
+// Helpers
+
+// SigVerifyCost returns the gas to be charged for verification of a signature made by the verification method.
+// For JsonWebKey2020 the key type is taken from the `kty` field of the key.
+func (p Params) SigVerifyCost(vm VerificationMethod) uint64 {
+	switch vm.Type {
+	case Ed25519VerificationKey2020:
+		return p.Ed25519SigVerifyCost
+	case EcdsaSecp256k1VerificationKey2019:
+		return p.EcdsaSigVerifyCost
+	case JsonWebKey2020:
+		switch PubKeyJWKToMap(vm.PublicKeyJwk)["kty"] {
+		case "RSA":
+			return p.RsaSigVerifyCost
+		case "EC":
+			return p.EcdsaSigVerifyCost
+		}
+	}
+
+	return p.Ed25519SigVerifyCost
+}
+
+// ValidateDidLimits checks that the number of verification methods, services and controllers
+// doesn't exceed the limits. Embedded verification methods are counted as well.
+func (p Params) ValidateDidLimits(did Did) error {
+	allVerificationMethods := did.GetAllVerificationMethods()
+
+	return validation.ValidateStruct(&did,
+		validation.Field(&did.VerificationMethod, validation.By(func(interface{}) error {
+			return validation.Validate(allVerificationMethods, validation.Length(0, int(p.MaxVerificationMethods)))
+		})),
+		validation.Field(&did.Service, validation.Length(0, int(p.MaxServices))),
+		validation.Field(&did.Controller, validation.Length(0, int(p.MaxControllers))),
+	)
+}
+
+// ValidateDidContext checks that the DID Doc lists in @context only the DID Core context
+// and contexts allowed by governance.
+func (p Params) ValidateDidContext(did Did) error {
+	allowedContexts := append([]string{DidCoreContext}, p.AllowedContexts...)
+
+	return validation.ValidateStruct(&did,
+		validation.Field(&did.Context, validation.Each(validation.In(utils.ToInterfaces(allowedContexts)...).Error("context is not allowed"))),
+	)
+}
+
+// IsCapabilityInvocationMethod checks that the verification method is authorized to change DID Docs
+// on behalf of the DID.
+func (p Params) IsCapabilityInvocationMethod(did Did, verificationMethodId string) bool {
+	return utils.Contains(did.GetCapabilityInvocationMethods(p.AuthenticationFallback), verificationMethodId)
+}
+
+// Validate
+
+func (p Params) Validate() error {
+	if err := validateUint64(p.DidDocByteCost); err != nil {
+		return fmt.Errorf("did doc byte cost: %w", err)
+	}
+
+	if err := validatePositiveUint64(p.Ed25519SigVerifyCost); err != nil {
+		return fmt.Errorf("ed25519 signature verification cost: %w", err)
+	}
+
+	if err := validatePositiveUint64(p.EcdsaSigVerifyCost); err != nil {
+		return fmt.Errorf("ecdsa signature verification cost: %w", err)
+	}
+
+	if err := validatePositiveUint64(p.RsaSigVerifyCost); err != nil {
+		return fmt.Errorf("rsa signature verification cost: %w", err)
+	}
+
+	if err := validatePositiveUint64(p.MaxVerificationMethods); err != nil {
+		return fmt.Errorf("max verification methods: %w", err)
+	}
+
+	if err := validatePositiveUint64(p.MaxServices); err != nil {
+		return fmt.Errorf("max services: %w", err)
+	}
+
+	if err := validatePositiveUint64(p.MaxControllers); err != nil {
+		return fmt.Errorf("max controllers: %w", err)
+	}
+
+	if err := validateContexts(p.AllowedContexts); err != nil {
+		return fmt.Errorf("allowed contexts: %w", err)
+	}
+
+	if err := validateUniqueIdFormats(p.UniqueIdFormats); err != nil {
+		return fmt.Errorf("unique id formats: %w", err)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateContexts(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, context := range v {
+		if err := utils.ValidateAbsoluteURL(context); err != nil {
+			return err
+		}
+	}
+
+	if !utils.IsUnique(v) {
+		return fmt.Errorf("there should be no duplicates")
+	}
+
+	return nil
+}
+
+func validateUniqueIdFormats(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return fmt.Errorf("at least one format is required")
+	}
+
+	for _, format := range v {
+		if !utils.Contains(SupportedUniqueIdFormats, format) {
+			return fmt.Errorf("unsupported format: %s", format)
+		}
+	}
+
+	if !utils.IsUnique(v) {
+		return fmt.Errorf("there should be no duplicates")
+	}
+
+	// Self-certifying ids are also valid 32-symbol base58 ids, so anyone could claim them without the key
+	if utils.Contains(v, UniqueIdFormatBase58) && utils.Contains(v, UniqueIdFormatSelfCertifying) {
+		return fmt.Errorf("%s and %s formats can't be allowed together", UniqueIdFormatBase58, UniqueIdFormatSelfCertifying)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("must be positive: %d", v)
+	}
+
+	return nil
+}
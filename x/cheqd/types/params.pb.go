@@ -0,0 +1,686 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the governance-controlled parameters of the cheqd module.
+type Params struct {
+	// Gas charged per byte of a stored DID document
+	DidDocByteCost uint64 `protobuf:"varint,1,opt,name=did_doc_byte_cost,json=didDocByteCost,proto3" json:"did_doc_byte_cost,omitempty"`
+	// Gas charged per signature verification, by key type
+	Ed25519SigVerifyCost uint64 `protobuf:"varint,2,opt,name=ed25519_sig_verify_cost,json=ed25519SigVerifyCost,proto3" json:"ed25519_sig_verify_cost,omitempty"`
+	EcdsaSigVerifyCost   uint64 `protobuf:"varint,3,opt,name=ecdsa_sig_verify_cost,json=ecdsaSigVerifyCost,proto3" json:"ecdsa_sig_verify_cost,omitempty"`
+	RsaSigVerifyCost     uint64 `protobuf:"varint,4,opt,name=rsa_sig_verify_cost,json=rsaSigVerifyCost,proto3" json:"rsa_sig_verify_cost,omitempty"`
+	// Limits on the size of DID documents
+	MaxVerificationMethods uint64 `protobuf:"varint,5,opt,name=max_verification_methods,json=maxVerificationMethods,proto3" json:"max_verification_methods,omitempty"`
+	MaxServices            uint64 `protobuf:"varint,6,opt,name=max_services,json=maxServices,proto3" json:"max_services,omitempty"`
+	MaxControllers         uint64 `protobuf:"varint,7,opt,name=max_controllers,json=maxControllers,proto3" json:"max_controllers,omitempty"`
+	// Allow verification methods referenced in authentication to authorize changes of DID documents
+	// controlled by DIDs without capabilityInvocation relationships. Disabled by default. DIDs without any
+	// verification relationships are authorized by any of their verification methods regardless of it.
+	AuthenticationFallback bool `protobuf:"varint,8,opt,name=authentication_fallback,json=authenticationFallback,proto3" json:"authentication_fallback,omitempty"`
+	// JSON-LD contexts DID documents may list in @context besides the DID Core context
+	AllowedContexts []string `protobuf:"bytes,9,rep,name=allowed_contexts,json=allowedContexts,proto3" json:"allowed_contexts,omitempty"`
+	// Unique id formats accepted for new DIDs in the DID namespace of the chain: base58, uuid, self-certifying.
+	// The list isn't keyed by namespace, as a chain accepts DIDs only in its own namespace, so the list
+	// applies to exactly one namespace. Chains serving different namespaces have their own params.
+	UniqueIdFormats []string `protobuf:"bytes,10,rep,name=unique_id_formats,json=uniqueIdFormats,proto3" json:"unique_id_formats,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4e8b0b9dda0170, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDidDocByteCost() uint64 {
+	if m != nil {
+		return m.DidDocByteCost
+	}
+	return 0
+}
+
+func (m *Params) GetEd25519SigVerifyCost() uint64 {
+	if m != nil {
+		return m.Ed25519SigVerifyCost
+	}
+	return 0
+}
+
+func (m *Params) GetEcdsaSigVerifyCost() uint64 {
+	if m != nil {
+		return m.EcdsaSigVerifyCost
+	}
+	return 0
+}
+
+func (m *Params) GetRsaSigVerifyCost() uint64 {
+	if m != nil {
+		return m.RsaSigVerifyCost
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVerificationMethods() uint64 {
+	if m != nil {
+		return m.MaxVerificationMethods
+	}
+	return 0
+}
+
+func (m *Params) GetMaxServices() uint64 {
+	if m != nil {
+		return m.MaxServices
+	}
+	return 0
+}
+
+func (m *Params) GetMaxControllers() uint64 {
+	if m != nil {
+		return m.MaxControllers
+	}
+	return 0
+}
+
+func (m *Params) GetAuthenticationFallback() bool {
+	if m != nil {
+		return m.AuthenticationFallback
+	}
+	return false
+}
+
+func (m *Params) GetAllowedContexts() []string {
+	if m != nil {
+		return m.AllowedContexts
+	}
+	return nil
+}
+
+func (m *Params) GetUniqueIdFormats() []string {
+	if m != nil {
+		return m.UniqueIdFormats
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cheqdid.cheqdnode.cheqd.v1.Params")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/params.proto", fileDescriptor_5c4e8b0b9dda0170) }
+
+var fileDescriptor_5c4e8b0b9dda0170 = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x1b, 0x3a, 0xca, 0x66, 0x10, 0xdb, 0x0c, 0xdb, 0x2c, 0x0e, 0x51, 0xe1, 0x42, 0x8b,
+	0xb4, 0x56, 0x05, 0x55, 0xc0, 0x75, 0x41, 0x93, 0x38, 0x20, 0xa1, 0x4e, 0xda, 0x81, 0x8b, 0xe5,
+	0xd8, 0x5f, 0x1b, 0x8b, 0x38, 0xee, 0x6c, 0x27, 0x24, 0xff, 0x82, 0x9f, 0xc5, 0x71, 0x47, 0x8e,
+	0x28, 0xfd, 0x23, 0xa8, 0x76, 0x10, 0x14, 0xb8, 0xd8, 0xd6, 0xfb, 0xbc, 0x8f, 0x6c, 0x59, 0x1f,
+	0x3a, 0xe1, 0x19, 0xdc, 0x88, 0x69, 0x35, 0x9b, 0xae, 0x99, 0x61, 0xca, 0x4e, 0xd6, 0x46, 0x3b,
+	0x8d, 0x9f, 0xf8, 0x58, 0x8a, 0x89, 0xdf, 0x0b, 0x2d, 0x20, 0x9c, 0x26, 0xd5, 0xec, 0x59, 0xdb,
+	0x47, 0x83, 0x8f, 0xbe, 0x8c, 0xc7, 0xe8, 0x58, 0x48, 0x41, 0x85, 0xe6, 0x34, 0x6d, 0x1c, 0x50,
+	0xae, 0xad, 0x23, 0xd1, 0x30, 0x1a, 0xed, 0x2d, 0x1e, 0x0a, 0x29, 0xde, 0x69, 0x7e, 0xd1, 0x38,
+	0x48, 0xb4, 0x75, 0x78, 0x8e, 0xce, 0x40, 0xbc, 0x9c, 0xcf, 0x67, 0x6f, 0xa9, 0x95, 0x2b, 0x5a,
+	0x81, 0x91, 0xcb, 0x26, 0x08, 0x77, 0xbc, 0xf0, 0xb8, 0xc3, 0x57, 0x72, 0x75, 0xed, 0xa1, 0xd7,
+	0x66, 0xe8, 0x04, 0xb8, 0xb0, 0xec, 0x1f, 0xa9, 0xef, 0x25, 0xec, 0xe1, 0xae, 0x72, 0x8e, 0x1e,
+	0x99, 0xff, 0x08, 0x7b, 0x5e, 0x38, 0x32, 0x7f, 0xd7, 0xdf, 0x20, 0xa2, 0x58, 0x1d, 0xaa, 0x92,
+	0x33, 0x27, 0x75, 0x41, 0x15, 0xb8, 0x4c, 0x0b, 0x4b, 0xee, 0x7a, 0xe7, 0x54, 0xb1, 0xfa, 0xfa,
+	0x0f, 0xfc, 0x21, 0x50, 0xfc, 0x14, 0x3d, 0xd8, 0x9a, 0x16, 0x4c, 0x25, 0x39, 0x58, 0x32, 0xf0,
+	0xed, 0xfb, 0x8a, 0xd5, 0x57, 0x5d, 0x84, 0x9f, 0xa3, 0xc3, 0x6d, 0x85, 0xeb, 0xc2, 0x19, 0x9d,
+	0xe7, 0x60, 0x2c, 0xb9, 0x17, 0xbe, 0x47, 0xb1, 0x3a, 0xf9, 0x9d, 0xe2, 0xd7, 0xe8, 0x8c, 0x95,
+	0x2e, 0x83, 0xc2, 0xfd, 0x7a, 0xc3, 0x92, 0xe5, 0x79, 0xca, 0xf8, 0x67, 0xb2, 0x3f, 0x8c, 0x46,
+	0xfb, 0x8b, 0xd3, 0x5d, 0x7c, 0xd9, 0x51, 0x3c, 0x46, 0x47, 0x2c, 0xcf, 0xf5, 0x17, 0x10, 0xfe,
+	0x16, 0xa8, 0x9d, 0x25, 0x07, 0xc3, 0xfe, 0xe8, 0x60, 0x71, 0xd8, 0xe5, 0x49, 0x17, 0xe3, 0x17,
+	0xe8, 0xb8, 0x2c, 0xe4, 0x4d, 0x09, 0x54, 0x0a, 0xba, 0xd4, 0x46, 0x31, 0x67, 0x09, 0x0a, 0xdd,
+	0x00, 0xde, 0x8b, 0xcb, 0x10, 0x5f, 0x24, 0xdf, 0xda, 0x38, 0xba, 0x6d, 0xe3, 0xe8, 0x47, 0x1b,
+	0x47, 0x5f, 0x37, 0x71, 0xef, 0x76, 0x13, 0xf7, 0xbe, 0x6f, 0xe2, 0xde, 0xa7, 0xf1, 0x4a, 0xba,
+	0xac, 0x4c, 0x27, 0x5c, 0xab, 0x69, 0x18, 0x1e, 0xbf, 0x9e, 0x6f, 0x87, 0x64, 0x5a, 0x77, 0x91,
+	0x6b, 0xd6, 0x60, 0xd3, 0x81, 0x1f, 0xa6, 0x57, 0x3f, 0x07, 0x00, 0xd3, 0x13, 0xb0, 0x6a, 0x65,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UniqueIdFormats) > 0 {
+		for iNdEx := len(m.UniqueIdFormats) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UniqueIdFormats[iNdEx])
+			copy(dAtA[i:], m.UniqueIdFormats[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.UniqueIdFormats[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllowedContexts) > 0 {
+		for iNdEx := len(m.AllowedContexts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContexts[iNdEx])
+			copy(dAtA[i:], m.AllowedContexts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedContexts[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.AuthenticationFallback {
+		i--
+		if m.AuthenticationFallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxControllers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxControllers))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxServices != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxServices))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxVerificationMethods != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVerificationMethods))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RsaSigVerifyCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RsaSigVerifyCost))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EcdsaSigVerifyCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EcdsaSigVerifyCost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Ed25519SigVerifyCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Ed25519SigVerifyCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DidDocByteCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DidDocByteCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocByteCost != 0 {
+		n += 1 + sovParams(uint64(m.DidDocByteCost))
+	}
+	if m.Ed25519SigVerifyCost != 0 {
+		n += 1 + sovParams(uint64(m.Ed25519SigVerifyCost))
+	}
+	if m.EcdsaSigVerifyCost != 0 {
+		n += 1 + sovParams(uint64(m.EcdsaSigVerifyCost))
+	}
+	if m.RsaSigVerifyCost != 0 {
+		n += 1 + sovParams(uint64(m.RsaSigVerifyCost))
+	}
+	if m.MaxVerificationMethods != 0 {
+		n += 1 + sovParams(uint64(m.MaxVerificationMethods))
+	}
+	if m.MaxServices != 0 {
+		n += 1 + sovParams(uint64(m.MaxServices))
+	}
+	if m.MaxControllers != 0 {
+		n += 1 + sovParams(uint64(m.MaxControllers))
+	}
+	if m.AuthenticationFallback {
+		n += 2
+	}
+	if len(m.AllowedContexts) > 0 {
+		for _, s := range m.AllowedContexts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.UniqueIdFormats) > 0 {
+		for _, s := range m.UniqueIdFormats {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocByteCost", wireType)
+			}
+			m.DidDocByteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DidDocByteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519SigVerifyCost", wireType)
+			}
+			m.Ed25519SigVerifyCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ed25519SigVerifyCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcdsaSigVerifyCost", wireType)
+			}
+			m.EcdsaSigVerifyCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EcdsaSigVerifyCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RsaSigVerifyCost", wireType)
+			}
+			m.RsaSigVerifyCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RsaSigVerifyCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVerificationMethods", wireType)
+			}
+			m.MaxVerificationMethods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVerificationMethods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxServices", wireType)
+			}
+			m.MaxServices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxServices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxControllers", wireType)
+			}
+			m.MaxControllers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxControllers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationFallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuthenticationFallback = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContexts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContexts = append(m.AllowedContexts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueIdFormats", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniqueIdFormats = append(m.UniqueIdFormats, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigVerifyCost(t *testing.T) {
+	params := DefaultParams()
+
+	cases := []struct {
+		name     string
+		vm       VerificationMethod
+		expected uint64
+	}{
+		{"Ed25519VerificationKey2020", VerificationMethod{Type: Ed25519VerificationKey2020}, params.Ed25519SigVerifyCost},
+		{"JWK RSA", VerificationMethod{Type: JsonWebKey2020, PublicKeyJwk: []*KeyValuePair{{Key: "kty", Value: "RSA"}}}, params.RsaSigVerifyCost},
+		{"JWK EC", VerificationMethod{Type: JsonWebKey2020, PublicKeyJwk: []*KeyValuePair{{Key: "kty", Value: "EC"}}}, params.EcdsaSigVerifyCost},
+		{"JWK OKP", VerificationMethod{Type: JsonWebKey2020, PublicKeyJwk: []*KeyValuePair{{Key: "kty", Value: "OKP"}}}, params.Ed25519SigVerifyCost},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.SigVerifyCost(tc.vm))
+		})
+	}
+}
+
+func TestParamsValidation(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.DidDocByteCost = 0
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxServices = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.RsaSigVerifyCost = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.AllowedContexts = append(params.AllowedContexts, "not-a-url")
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.AllowedContexts = append(params.AllowedContexts, params.AllowedContexts[0])
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.UniqueIdFormats = []string{UniqueIdFormatUUID, UniqueIdFormatSelfCertifying}
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.UniqueIdFormats = nil
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.UniqueIdFormats = []string{"hex"}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.UniqueIdFormats = []string{UniqueIdFormatUUID, UniqueIdFormatUUID}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.UniqueIdFormats = []string{UniqueIdFormatBase58, UniqueIdFormatSelfCertifying}
+	require.EqualError(t, params.Validate(), "unique id formats: base58 and self-certifying formats can't be allowed together")
+}
+
+func TestValidateDidContext(t *testing.T) {
+	params := DefaultParams()
+
+	did := Did{Id: ValidTestDID, Context: []string{DidCoreContext, DefaultAllowedContexts[0]}}
+	require.NoError(t, params.ValidateDidContext(did))
+
+	did.Context = append(did.Context, "https://example.com/context/v1")
+	require.EqualError(t, params.ValidateDidContext(did), "context: (2: context is not allowed.).")
+
+	params.AllowedContexts = append(params.AllowedContexts, "https://example.com/context/v1")
+	require.NoError(t, params.ValidateDidContext(did))
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -30,6 +31,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryGetDidRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Return ICS-23 proof of the DID Doc against the app hash
+	Prove bool `protobuf:"varint,2,opt,name=prove,proto3" json:"prove,omitempty"`
 }
 
 func (m *QueryGetDidRequest) Reset()         { *m = QueryGetDidRequest{} }
@@ -72,9 +75,22 @@ func (m *QueryGetDidRequest) GetId() string {
 	return ""
 }
 
+func (m *QueryGetDidRequest) GetProve() bool {
+	if m != nil {
+		return m.Prove
+	}
+	return false
+}
+
 type QueryGetDidResponse struct {
 	Did      *Did      `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 	Metadata *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Proof of the DID Doc in the cheqd store followed by the proof of the store against the app hash
+	Proof *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Height of the state the proof is calculated for. The app hash is contained in the header of the next block.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// StateValue as it is stored, the proof is calculated for these bytes. Set along with the proof.
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (m *QueryGetDidResponse) Reset()         { *m = QueryGetDidResponse{} }
@@ -124,35 +140,148 @@ func (m *QueryGetDidResponse) GetMetadata() *Metadata {
 	return nil
 }
 
+func (m *QueryGetDidResponse) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryGetDidResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryGetDidResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2982774eb5e71a9, []int{2}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2982774eb5e71a9, []int{3}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetDidRequest)(nil), "cheqdid.cheqdnode.cheqd.v1.QueryGetDidRequest")
 	proto.RegisterType((*QueryGetDidResponse)(nil), "cheqdid.cheqdnode.cheqd.v1.QueryGetDidResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cheqdid.cheqdnode.cheqd.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cheqdid.cheqdnode.cheqd.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("cheqd/v1/query.proto", fileDescriptor_a2982774eb5e71a9) }
 
 var fileDescriptor_a2982774eb5e71a9 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0xce, 0x48, 0x2d,
-	0x4c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x02, 0x8b, 0x66, 0xa6, 0xe8, 0x81, 0xe9, 0xbc, 0xfc, 0x94, 0x54, 0x08, 0x4b, 0xaf,
-	0xcc, 0x50, 0x4a, 0x26, 0x3d, 0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53, 0x3f, 0x31,
-	0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24, 0x33, 0x3f, 0xaf, 0x18, 0xa2, 0x53, 0x4a, 0x08, 0x6e, 0x1e,
-	0x48, 0x3b, 0x44, 0x4c, 0x12, 0x2e, 0x56, 0x5c, 0x92, 0x58, 0x92, 0x1a, 0x96, 0x98, 0x53, 0x9a,
-	0x0a, 0x91, 0x52, 0x52, 0xe1, 0x12, 0x0a, 0x04, 0xd9, 0xeb, 0x9e, 0x5a, 0xe2, 0x92, 0x99, 0x12,
-	0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x22, 0xc4, 0xc7, 0xc5, 0x94, 0x99, 0x22, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0x19, 0xc4, 0x94, 0x99, 0xa2, 0xd4, 0xc5, 0xc8, 0x25, 0x8c, 0xa2, 0xac, 0xb8, 0x20,
-	0x3f, 0xaf, 0x38, 0x55, 0xc8, 0x90, 0x8b, 0x39, 0x05, 0xaa, 0x90, 0xdb, 0x48, 0x5e, 0x0f, 0xb7,
-	0xa3, 0xf5, 0x40, 0xba, 0x40, 0x6a, 0x85, 0x1c, 0xb8, 0x38, 0x72, 0x53, 0x4b, 0x12, 0x53, 0x12,
-	0x4b, 0x12, 0x25, 0x98, 0xc0, 0xfa, 0x54, 0xf0, 0xe9, 0xf3, 0x85, 0xaa, 0x0d, 0x82, 0xeb, 0x32,
-	0xea, 0x61, 0xe4, 0x62, 0x05, 0x3b, 0x46, 0xa8, 0x89, 0x91, 0x8b, 0xd9, 0x25, 0x33, 0x45, 0x48,
-	0x0f, 0x9f, 0x09, 0x98, 0xde, 0x93, 0xd2, 0x27, 0x5a, 0x3d, 0xc4, 0x9f, 0x4a, 0x52, 0x4d, 0x97,
-	0x9f, 0x4c, 0x66, 0x12, 0x11, 0x12, 0xd2, 0x47, 0x0e, 0x5d, 0xfd, 0xea, 0xcc, 0x94, 0x5a, 0x27,
-	0xe7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
-	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0x85, 0xea, 0x03, 0x93, 0xba, 0x20, 0xfb, 0xf4, 0x2b, 0xa0,
-	0x42, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xd8, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x78, 0x27, 0xa2, 0xa0, 0x0e, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x8e, 0x13, 0x31,
+	0x10, 0x86, 0xe3, 0x0d, 0x89, 0x0e, 0x1f, 0x42, 0x68, 0x2e, 0xa0, 0x65, 0x81, 0x25, 0x5a, 0x51,
+	0x84, 0x02, 0x5b, 0x09, 0xdd, 0x55, 0x08, 0x4e, 0xa2, 0x42, 0xdc, 0x6d, 0x41, 0x41, 0xe7, 0x8b,
+	0xe7, 0x12, 0x4b, 0x97, 0xb5, 0xb3, 0xeb, 0x44, 0x44, 0x88, 0xe6, 0x78, 0x01, 0x04, 0x2f, 0x45,
+	0x79, 0x12, 0x0d, 0x25, 0x4a, 0xe8, 0x78, 0x09, 0xb4, 0xb6, 0x09, 0x04, 0x44, 0x94, 0x26, 0x59,
+	0xcf, 0xcc, 0x37, 0xfe, 0xe7, 0xf7, 0xd0, 0xce, 0x70, 0x8c, 0x53, 0xc9, 0xe7, 0x7d, 0x3e, 0x9d,
+	0x61, 0xb9, 0x60, 0xa6, 0xd4, 0x56, 0x43, 0xe2, 0xa2, 0x4a, 0x32, 0xf7, 0x5f, 0x68, 0x89, 0xfe,
+	0x8b, 0xcd, 0xfb, 0xc9, 0xdd, 0x91, 0xd6, 0xa3, 0x73, 0xe4, 0xc2, 0x28, 0x2e, 0x8a, 0x42, 0x5b,
+	0x61, 0x95, 0x2e, 0x2a, 0x4f, 0x26, 0xb0, 0xee, 0x57, 0xe3, 0x3e, 0x76, 0x7b, 0x1d, 0xab, 0xac,
+	0xb0, 0xf8, 0x4a, 0x9c, 0xcf, 0x30, 0xa4, 0x6e, 0xae, 0x53, 0x46, 0x94, 0x62, 0xf2, 0xab, 0xcb,
+	0x3d, 0x8b, 0x85, 0xc4, 0x72, 0xa2, 0x0a, 0xcb, 0x87, 0xe5, 0xc2, 0x58, 0xcd, 0x4d, 0xa9, 0xf5,
+	0x99, 0x4f, 0x67, 0x87, 0x14, 0x4e, 0x6a, 0xb5, 0xcf, 0xd1, 0x1e, 0x29, 0x99, 0xe3, 0x74, 0x86,
+	0x95, 0x85, 0xeb, 0x34, 0x52, 0x32, 0x26, 0x5d, 0xd2, 0xbb, 0x9a, 0x47, 0x4a, 0x42, 0x87, 0xb6,
+	0x4c, 0xa9, 0xe7, 0x18, 0x47, 0x5d, 0xd2, 0xdb, 0xcb, 0xfd, 0x21, 0xfb, 0x41, 0xe8, 0xc1, 0x06,
+	0x5c, 0x19, 0x5d, 0x54, 0x08, 0x7d, 0xda, 0x94, 0x01, 0xdf, 0x1f, 0xdc, 0x67, 0xff, 0x37, 0x80,
+	0xd5, 0x54, 0x5d, 0x0b, 0x4f, 0xe8, 0xde, 0x04, 0xad, 0x90, 0xc2, 0x0a, 0x77, 0xc7, 0xfe, 0xe0,
+	0xc1, 0x36, 0xee, 0x45, 0xa8, 0xcd, 0xd7, 0x14, 0xf4, 0x9d, 0x44, 0x7d, 0x16, 0x37, 0x1d, 0x7e,
+	0x87, 0xfd, 0x9e, 0x9b, 0xf9, 0xb9, 0xd9, 0x71, 0x9d, 0x7f, 0x69, 0xaa, 0xdc, 0x57, 0xc2, 0x2d,
+	0xda, 0x1e, 0xa3, 0x1a, 0x8d, 0x6d, 0x7c, 0xa5, 0x4b, 0x7a, 0xcd, 0x3c, 0x9c, 0xea, 0x69, 0xe7,
+	0xb5, 0xb1, 0x71, 0xab, 0x4b, 0x7a, 0xd7, 0x72, 0x7f, 0xc8, 0x3a, 0xc1, 0xa9, 0x63, 0xe7, 0x6e,
+	0x70, 0x2a, 0x3b, 0xa1, 0x07, 0x1b, 0xd1, 0x60, 0xc1, 0x21, 0x6d, 0xfb, 0x57, 0x08, 0x2e, 0x64,
+	0xdb, 0xa6, 0x09, 0x6c, 0x20, 0x06, 0x1f, 0x23, 0xda, 0x72, 0x3d, 0xe1, 0x82, 0xd0, 0xe6, 0x91,
+	0x92, 0xc0, 0xb6, 0xd1, 0xff, 0x3e, 0x5f, 0xc2, 0x77, 0xae, 0xf7, 0x72, 0xb3, 0xe4, 0xe2, 0xcb,
+	0xf7, 0x4f, 0x51, 0x07, 0x80, 0xff, 0xb9, 0x73, 0xfc, 0xad, 0x92, 0xef, 0xe0, 0x3d, 0xa1, 0x6d,
+	0xaf, 0x70, 0x07, 0x1d, 0x1b, 0xe6, 0x24, 0x7c, 0xe7, 0xfa, 0xa0, 0x23, 0x76, 0x3a, 0x00, 0x6e,
+	0xf0, 0xbf, 0x96, 0xf9, 0xe9, 0xb3, 0xcf, 0xcb, 0x94, 0x5c, 0x2e, 0x53, 0xf2, 0x6d, 0x99, 0x92,
+	0x0f, 0xab, 0xb4, 0x71, 0xb9, 0x4a, 0x1b, 0x5f, 0x57, 0x69, 0xe3, 0xf5, 0xc3, 0x91, 0xb2, 0xe3,
+	0xd9, 0x29, 0x1b, 0xea, 0x49, 0xa0, 0xdc, 0xef, 0xa3, 0xfa, 0x36, 0xfe, 0x26, 0x84, 0xec, 0xc2,
+	0x60, 0x75, 0xda, 0x76, 0x3b, 0xff, 0xf8, 0xe7, 0x00, 0x61, 0xf2, 0x9a, 0xb4, 0xaa, 0x03, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -168,6 +297,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Did(ctx context.Context, in *QueryGetDidRequest, opts ...grpc.CallOption) (*QueryGetDidResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -187,9 +317,19 @@ func (c *queryClient) Did(ctx context.Context, in *QueryGetDidRequest, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cheqdid.cheqdnode.cheqd.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Did(context.Context, *QueryGetDidRequest) (*QueryGetDidResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -199,6 +339,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Did(ctx context.Context, req *QueryGetDidRequest) (*QueryGetDidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Did not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -222,6 +365,24 @@ func _Query_Did_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqdid.cheqdnode.cheqd.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cheqdid.cheqdnode.cheqd.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -230,6 +391,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Did",
 			Handler:    _Query_Did_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/v1/query.proto",
@@ -255,6 +420,16 @@ func (m *QueryGetDidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Prove {
+		i--
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -285,6 +460,30 @@ func (m *QueryGetDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Metadata != nil {
 		{
 			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
@@ -312,6 +511,64 @@ func (m *QueryGetDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -333,6 +590,9 @@ func (m *QueryGetDidRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Prove {
+		n += 2
+	}
 	return n
 }
 
@@ -350,6 +610,39 @@ func (m *QueryGetDidResponse) Size() (n int) {
 		l = m.Metadata.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -420,6 +713,26 @@ func (m *QueryGetDidRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -542,6 +855,231 @@ func (m *QueryGetDidResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,746 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/snapshot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DidSnapshotHeader is the first item of a DID snapshot
+type DidSnapshotHeader struct {
+	Format uint32 `protobuf:"varint,1,opt,name=format,proto3" json:"format,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Root hash of the cheqd module store at the height
+	StoreHash []byte `protobuf:"bytes,3,opt,name=store_hash,json=storeHash,proto3" json:"store_hash,omitempty"`
+	// Proof of the store hash against the app hash
+	StoreProof *crypto.ProofOp `protobuf:"bytes,4,opt,name=store_proof,json=storeProof,proto3" json:"store_proof,omitempty"`
+}
+
+func (m *DidSnapshotHeader) Reset()         { *m = DidSnapshotHeader{} }
+func (m *DidSnapshotHeader) String() string { return proto.CompactTextString(m) }
+func (*DidSnapshotHeader) ProtoMessage()    {}
+func (*DidSnapshotHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19d1767ef586cb5f, []int{0}
+}
+func (m *DidSnapshotHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidSnapshotHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidSnapshotHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidSnapshotHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidSnapshotHeader.Merge(m, src)
+}
+func (m *DidSnapshotHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidSnapshotHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidSnapshotHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidSnapshotHeader proto.InternalMessageInfo
+
+func (m *DidSnapshotHeader) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
+	}
+	return 0
+}
+
+func (m *DidSnapshotHeader) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DidSnapshotHeader) GetStoreHash() []byte {
+	if m != nil {
+		return m.StoreHash
+	}
+	return nil
+}
+
+func (m *DidSnapshotHeader) GetStoreProof() *crypto.ProofOp {
+	if m != nil {
+		return m.StoreProof
+	}
+	return nil
+}
+
+// DidSnapshotItem is a key-value pair of the cheqd module store with a proof against the store hash
+type DidSnapshotItem struct {
+	Key   []byte          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Proof *crypto.ProofOp `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *DidSnapshotItem) Reset()         { *m = DidSnapshotItem{} }
+func (m *DidSnapshotItem) String() string { return proto.CompactTextString(m) }
+func (*DidSnapshotItem) ProtoMessage()    {}
+func (*DidSnapshotItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19d1767ef586cb5f, []int{1}
+}
+func (m *DidSnapshotItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidSnapshotItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidSnapshotItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidSnapshotItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidSnapshotItem.Merge(m, src)
+}
+func (m *DidSnapshotItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidSnapshotItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidSnapshotItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidSnapshotItem proto.InternalMessageInfo
+
+func (m *DidSnapshotItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *DidSnapshotItem) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DidSnapshotItem) GetProof() *crypto.ProofOp {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DidSnapshotHeader)(nil), "cheqdid.cheqdnode.cheqd.v1.DidSnapshotHeader")
+	proto.RegisterType((*DidSnapshotItem)(nil), "cheqdid.cheqdnode.cheqd.v1.DidSnapshotItem")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/snapshot.proto", fileDescriptor_19d1767ef586cb5f) }
+
+var fileDescriptor_19d1767ef586cb5f = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0xad, 0x5f, 0x5e, 0x2b, 0xe1, 0x16, 0x01, 0x16, 0x82, 0xa8, 0x52, 0xa3, 0xa8, 0x53, 0x18,
+	0x70, 0x28, 0x8c, 0x6c, 0xc0, 0x50, 0x26, 0x50, 0xd8, 0x58, 0x90, 0x5b, 0xdf, 0xd6, 0x51, 0x49,
+	0x6c, 0x6c, 0xb7, 0xa2, 0x7f, 0xc1, 0x1f, 0xf0, 0x3b, 0x8c, 0x1d, 0x19, 0x51, 0xf3, 0x23, 0x28,
+	0x76, 0x24, 0xd8, 0x58, 0xec, 0x7b, 0xce, 0xb1, 0xef, 0x39, 0xba, 0x17, 0x1f, 0x4f, 0x05, 0xbc,
+	0xf0, 0x74, 0x35, 0x4a, 0x4d, 0xc9, 0x94, 0x11, 0xd2, 0x52, 0xa5, 0xa5, 0x95, 0xa4, 0xef, 0x84,
+	0x9c, 0x53, 0x77, 0x97, 0x92, 0x83, 0xaf, 0xe8, 0x6a, 0xd4, 0x1f, 0x58, 0x28, 0x39, 0xe8, 0x22,
+	0x2f, 0x6d, 0x3a, 0xd5, 0x6b, 0x65, 0x65, 0xaa, 0xb4, 0x94, 0x33, 0xff, 0x75, 0xf8, 0x8e, 0xf0,
+	0xc1, 0x4d, 0xce, 0x1f, 0x9a, 0x86, 0x63, 0x60, 0x1c, 0x34, 0x39, 0xc2, 0x9d, 0x99, 0xd4, 0x05,
+	0xb3, 0x21, 0x8a, 0x51, 0xb2, 0x9b, 0x35, 0xa8, 0xe6, 0x05, 0xe4, 0x73, 0x61, 0xc3, 0x7f, 0x31,
+	0x4a, 0x82, 0xac, 0x41, 0x64, 0x80, 0xb1, 0xb1, 0x52, 0xc3, 0x93, 0x60, 0x46, 0x84, 0x41, 0x8c,
+	0x92, 0x5e, 0xb6, 0xe3, 0x98, 0x31, 0x33, 0x82, 0x5c, 0xe2, 0xae, 0x97, 0x9d, 0x73, 0xf8, 0x3f,
+	0x46, 0x49, 0xf7, 0xbc, 0x4f, 0x7f, 0x92, 0x51, 0x9f, 0x8c, 0xde, 0xd7, 0xfa, 0x9d, 0xca, 0x7c,
+	0x37, 0x87, 0x86, 0x0b, 0xbc, 0xf7, 0x2b, 0xe0, 0xad, 0x85, 0x82, 0xec, 0xe3, 0x60, 0x01, 0x6b,
+	0x97, 0xad, 0x97, 0xd5, 0x25, 0x39, 0xc4, 0xed, 0x15, 0x7b, 0x5e, 0x82, 0xcb, 0xd5, 0xcb, 0x3c,
+	0x20, 0x67, 0xb8, 0xed, 0x1d, 0x83, 0x3f, 0x1d, 0xfd, 0xc3, 0xab, 0xeb, 0x8f, 0x6d, 0x84, 0x36,
+	0xdb, 0x08, 0x7d, 0x6d, 0x23, 0xf4, 0x56, 0x45, 0xad, 0x4d, 0x15, 0xb5, 0x3e, 0xab, 0xa8, 0xf5,
+	0x78, 0x32, 0xcf, 0xad, 0x58, 0x4e, 0xe8, 0x54, 0x16, 0xa9, 0xdf, 0x83, 0x3b, 0x4f, 0xeb, 0x69,
+	0xa7, 0xaf, 0x0d, 0x65, 0xd7, 0x0a, 0xcc, 0xa4, 0xe3, 0x46, 0x7b, 0xf1, 0x3d, 0x00, 0x76, 0x83,
+	0xb9, 0xa5, 0xb0, 0x01, 0x00, 0x00,
+}
+
+func (m *DidSnapshotHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidSnapshotHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidSnapshotHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StoreProof != nil {
+		{
+			size, err := m.StoreProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StoreHash) > 0 {
+		i -= len(m.StoreHash)
+		copy(dAtA[i:], m.StoreHash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.StoreHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Format != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidSnapshotItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidSnapshotItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidSnapshotItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DidSnapshotHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Format != 0 {
+		n += 1 + sovSnapshot(uint64(m.Format))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	l = len(m.StoreHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.StoreProof != nil {
+		l = m.StoreProof.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *DidSnapshotItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DidSnapshotHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidSnapshotHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidSnapshotHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreHash = append(m.StoreHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.StoreHash == nil {
+				m.StoreHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoreProof == nil {
+				m.StoreProof = &crypto.ProofOp{}
+			}
+			if err := m.StoreProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidSnapshotItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidSnapshotItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOp{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
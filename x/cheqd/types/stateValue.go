@@ -62,5 +62,8 @@ func (m StateValue) UnpackDataAsDid() (*Did, error) {
 		return nil, ErrUnpackStateValue.Wrap(reflect.TypeOf(data).String())
 	}
 
+	// DID Docs stored or exported before v0.6
+	value.MergeLegacyVerificationRelationships()
+
 	return value, nil
 }
@@ -1,5 +1,16 @@
 package types
 
+import "github.com/gogo/protobuf/proto"
+
+// ReplayProtectedPayload is implemented by payloads that can be bound to a chain and expire
+type ReplayProtectedPayload interface {
+	GetChainId() string
+	GetExpiryHeight() uint64
+	GetExpiryTime() uint64
+}
+
 type IdentityMsg interface {
+	proto.Message
 	GetSignBytes() []byte
+	GetSignBytesForMode(mode SignMode) ([]byte, error)
 }
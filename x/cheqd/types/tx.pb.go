@@ -28,10 +28,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SignMode defines the representation of the payload that is signed
+type SignMode int32
+
+const (
+	// Binary encoding of the payload
+	SignMode_SIGN_MODE_BINARY SignMode = 0
+	// Canonical JSON form of the payload (JCS, RFC 8785)
+	SignMode_SIGN_MODE_JCS SignMode = 1
+)
+
+var SignMode_name = map[int32]string{
+	0: "SIGN_MODE_BINARY",
+	1: "SIGN_MODE_JCS",
+}
+
+var SignMode_value = map[string]int32{
+	"SIGN_MODE_BINARY": 0,
+	"SIGN_MODE_JCS":    1,
+}
+
+func (x SignMode) String() string {
+	return proto.EnumName(SignMode_name, int32(x))
+}
+
+func (SignMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef903f85b95effd2, []int{0}
+}
+
 // this line is used by starport scaffolding # proto/tx/message
 type MsgCreateDid struct {
 	Payload    *MsgCreateDidPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 	Signatures []*SignInfo          `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	SignMode   SignMode             `protobuf:"varint,3,opt,name=sign_mode,json=signMode,proto3,enum=cheqdid.cheqdnode.cheqd.v1.SignMode" json:"sign_mode,omitempty"`
+	// Account submitting the operation. Optional. If set, the account must sign
+	// the transaction or grant DidOperationAuthorization to the account executing it.
+	// Required to be the bound account of DIDs bound to an account.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (m *MsgCreateDid) Reset()         { *m = MsgCreateDid{} }
@@ -81,9 +114,28 @@ func (m *MsgCreateDid) GetSignatures() []*SignInfo {
 	return nil
 }
 
+func (m *MsgCreateDid) GetSignMode() SignMode {
+	if m != nil {
+		return m.SignMode
+	}
+	return SignMode_SIGN_MODE_BINARY
+}
+
+func (m *MsgCreateDid) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
 type MsgUpdateDid struct {
 	Payload    *MsgUpdateDidPayload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 	Signatures []*SignInfo          `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	SignMode   SignMode             `protobuf:"varint,3,opt,name=sign_mode,json=signMode,proto3,enum=cheqdid.cheqdnode.cheqd.v1.SignMode" json:"sign_mode,omitempty"`
+	// Account submitting the operation. Optional. If set, the account must sign
+	// the transaction or grant DidOperationAuthorization to the account executing it.
+	// Required to be the bound account of DIDs bound to an account.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (m *MsgUpdateDid) Reset()         { *m = MsgUpdateDid{} }
@@ -133,6 +185,20 @@ func (m *MsgUpdateDid) GetSignatures() []*SignInfo {
 	return nil
 }
 
+func (m *MsgUpdateDid) GetSignMode() SignMode {
+	if m != nil {
+		return m.SignMode
+	}
+	return SignMode_SIGN_MODE_BINARY
+}
+
+func (m *MsgUpdateDid) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
 type SignInfo struct {
 	VerificationMethodId string `protobuf:"bytes,1,opt,name=verification_method_id,json=verificationMethodId,proto3" json:"verification_method_id,omitempty"`
 	Signature            string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
@@ -186,17 +252,31 @@ func (m *SignInfo) GetSignature() string {
 }
 
 type MsgCreateDidPayload struct {
-	Context              []string              `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
-	Id                   string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Controller           []string              `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
-	VerificationMethod   []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
-	Authentication       []string              `protobuf:"bytes,5,rep,name=authentication,proto3" json:"authentication,omitempty"`
-	AssertionMethod      []string              `protobuf:"bytes,6,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
-	CapabilityInvocation []string              `protobuf:"bytes,7,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
-	CapabilityDelegation []string              `protobuf:"bytes,8,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
-	KeyAgreement         []string              `protobuf:"bytes,9,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
-	AlsoKnownAs          []string              `protobuf:"bytes,10,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
-	Service              []*Service            `protobuf:"bytes,11,rep,name=service,proto3" json:"service,omitempty"`
+	Context            []string              `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
+	Id                 string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Controller         []string              `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
+	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// Verification relationships as lists of verification method ids, encoded this way before v0.6.
+	// Deprecated: merged into the verification relationships below.
+	LegacyAuthentication       []string   `protobuf:"bytes,5,rep,name=legacy_authentication,json=legacyAuthentication,proto3" json:"legacy_authentication,omitempty"`                     // Deprecated: Do not use.
+	LegacyAssertionMethod      []string   `protobuf:"bytes,6,rep,name=legacy_assertion_method,json=legacyAssertionMethod,proto3" json:"legacy_assertion_method,omitempty"`                // Deprecated: Do not use.
+	LegacyCapabilityInvocation []string   `protobuf:"bytes,7,rep,name=legacy_capability_invocation,json=legacyCapabilityInvocation,proto3" json:"legacy_capability_invocation,omitempty"` // Deprecated: Do not use.
+	LegacyCapabilityDelegation []string   `protobuf:"bytes,8,rep,name=legacy_capability_delegation,json=legacyCapabilityDelegation,proto3" json:"legacy_capability_delegation,omitempty"` // Deprecated: Do not use.
+	LegacyKeyAgreement         []string   `protobuf:"bytes,9,rep,name=legacy_key_agreement,json=legacyKeyAgreement,proto3" json:"legacy_key_agreement,omitempty"`                         // Deprecated: Do not use.
+	AlsoKnownAs                []string   `protobuf:"bytes,10,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	Service                    []*Service `protobuf:"bytes,11,rep,name=service,proto3" json:"service,omitempty"`
+	// Optional replay protection. If chain_id is set, the payload is accepted only by the chain with this id.
+	// If expiry_height or expiry_time (unix seconds) is set, the payload is rejected after this block height or time.
+	ChainId              string                      `protobuf:"bytes,12,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ExpiryHeight         uint64                      `protobuf:"varint,13,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime           uint64                      `protobuf:"varint,14,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Authentication       []*VerificationRelationship `protobuf:"bytes,15,rep,name=authentication,proto3" json:"authentication,omitempty"`
+	AssertionMethod      []*VerificationRelationship `protobuf:"bytes,16,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	CapabilityInvocation []*VerificationRelationship `protobuf:"bytes,17,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
+	CapabilityDelegation []*VerificationRelationship `protobuf:"bytes,18,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
+	KeyAgreement         []*VerificationRelationship `protobuf:"bytes,19,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	// Account the DID is bound to, see Did.bound_account
+	BoundAccount string `protobuf:"bytes,20,opt,name=bound_account,json=boundAccount,proto3" json:"bound_account,omitempty"`
 }
 
 func (m *MsgCreateDidPayload) Reset()         { *m = MsgCreateDidPayload{} }
@@ -260,37 +340,42 @@ func (m *MsgCreateDidPayload) GetVerificationMethod() []*VerificationMethod {
 	return nil
 }
 
-func (m *MsgCreateDidPayload) GetAuthentication() []string {
+// Deprecated: Do not use.
+func (m *MsgCreateDidPayload) GetLegacyAuthentication() []string {
 	if m != nil {
-		return m.Authentication
+		return m.LegacyAuthentication
 	}
 	return nil
 }
 
-func (m *MsgCreateDidPayload) GetAssertionMethod() []string {
+// Deprecated: Do not use.
+func (m *MsgCreateDidPayload) GetLegacyAssertionMethod() []string {
 	if m != nil {
-		return m.AssertionMethod
+		return m.LegacyAssertionMethod
 	}
 	return nil
 }
 
-func (m *MsgCreateDidPayload) GetCapabilityInvocation() []string {
+// Deprecated: Do not use.
+func (m *MsgCreateDidPayload) GetLegacyCapabilityInvocation() []string {
 	if m != nil {
-		return m.CapabilityInvocation
+		return m.LegacyCapabilityInvocation
 	}
 	return nil
 }
 
-func (m *MsgCreateDidPayload) GetCapabilityDelegation() []string {
+// Deprecated: Do not use.
+func (m *MsgCreateDidPayload) GetLegacyCapabilityDelegation() []string {
 	if m != nil {
-		return m.CapabilityDelegation
+		return m.LegacyCapabilityDelegation
 	}
 	return nil
 }
 
-func (m *MsgCreateDidPayload) GetKeyAgreement() []string {
+// Deprecated: Do not use.
+func (m *MsgCreateDidPayload) GetLegacyKeyAgreement() []string {
 	if m != nil {
-		return m.KeyAgreement
+		return m.LegacyKeyAgreement
 	}
 	return nil
 }
@@ -309,6 +394,69 @@ func (m *MsgCreateDidPayload) GetService() []*Service {
 	return nil
 }
 
+func (m *MsgCreateDidPayload) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgCreateDidPayload) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateDidPayload) GetExpiryTime() uint64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *MsgCreateDidPayload) GetAuthentication() []*VerificationRelationship {
+	if m != nil {
+		return m.Authentication
+	}
+	return nil
+}
+
+func (m *MsgCreateDidPayload) GetAssertionMethod() []*VerificationRelationship {
+	if m != nil {
+		return m.AssertionMethod
+	}
+	return nil
+}
+
+func (m *MsgCreateDidPayload) GetCapabilityInvocation() []*VerificationRelationship {
+	if m != nil {
+		return m.CapabilityInvocation
+	}
+	return nil
+}
+
+func (m *MsgCreateDidPayload) GetCapabilityDelegation() []*VerificationRelationship {
+	if m != nil {
+		return m.CapabilityDelegation
+	}
+	return nil
+}
+
+func (m *MsgCreateDidPayload) GetKeyAgreement() []*VerificationRelationship {
+	if m != nil {
+		return m.KeyAgreement
+	}
+	return nil
+}
+
+func (m *MsgCreateDidPayload) GetBoundAccount() string {
+	if m != nil {
+		return m.BoundAccount
+	}
+	return ""
+}
+
 type MsgCreateDidResponse struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -354,18 +502,30 @@ func (m *MsgCreateDidResponse) GetId() string {
 }
 
 type MsgUpdateDidPayload struct {
-	Context              []string              `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
-	Id                   string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Controller           []string              `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
-	VerificationMethod   []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
-	Authentication       []string              `protobuf:"bytes,5,rep,name=authentication,proto3" json:"authentication,omitempty"`
-	AssertionMethod      []string              `protobuf:"bytes,6,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
-	CapabilityInvocation []string              `protobuf:"bytes,7,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
-	CapabilityDelegation []string              `protobuf:"bytes,8,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
-	KeyAgreement         []string              `protobuf:"bytes,9,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
-	AlsoKnownAs          []string              `protobuf:"bytes,10,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
-	Service              []*Service            `protobuf:"bytes,11,rep,name=service,proto3" json:"service,omitempty"`
-	VersionId            string                `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Context            []string              `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
+	Id                 string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Controller         []string              `protobuf:"bytes,3,rep,name=controller,proto3" json:"controller,omitempty"`
+	VerificationMethod []*VerificationMethod `protobuf:"bytes,4,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// Verification relationships as lists of verification method ids, see MsgCreateDidPayload
+	LegacyAuthentication       []string   `protobuf:"bytes,5,rep,name=legacy_authentication,json=legacyAuthentication,proto3" json:"legacy_authentication,omitempty"`                     // Deprecated: Do not use.
+	LegacyAssertionMethod      []string   `protobuf:"bytes,6,rep,name=legacy_assertion_method,json=legacyAssertionMethod,proto3" json:"legacy_assertion_method,omitempty"`                // Deprecated: Do not use.
+	LegacyCapabilityInvocation []string   `protobuf:"bytes,7,rep,name=legacy_capability_invocation,json=legacyCapabilityInvocation,proto3" json:"legacy_capability_invocation,omitempty"` // Deprecated: Do not use.
+	LegacyCapabilityDelegation []string   `protobuf:"bytes,8,rep,name=legacy_capability_delegation,json=legacyCapabilityDelegation,proto3" json:"legacy_capability_delegation,omitempty"` // Deprecated: Do not use.
+	LegacyKeyAgreement         []string   `protobuf:"bytes,9,rep,name=legacy_key_agreement,json=legacyKeyAgreement,proto3" json:"legacy_key_agreement,omitempty"`                         // Deprecated: Do not use.
+	AlsoKnownAs                []string   `protobuf:"bytes,10,rep,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+	Service                    []*Service `protobuf:"bytes,11,rep,name=service,proto3" json:"service,omitempty"`
+	VersionId                  string     `protobuf:"bytes,12,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Optional replay protection, see MsgCreateDidPayload
+	ChainId              string                      `protobuf:"bytes,13,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ExpiryHeight         uint64                      `protobuf:"varint,14,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime           uint64                      `protobuf:"varint,15,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Authentication       []*VerificationRelationship `protobuf:"bytes,16,rep,name=authentication,proto3" json:"authentication,omitempty"`
+	AssertionMethod      []*VerificationRelationship `protobuf:"bytes,17,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	CapabilityInvocation []*VerificationRelationship `protobuf:"bytes,18,rep,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
+	CapabilityDelegation []*VerificationRelationship `protobuf:"bytes,19,rep,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
+	KeyAgreement         []*VerificationRelationship `protobuf:"bytes,20,rep,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	// Account the DID is bound to, see Did.bound_account
+	BoundAccount string `protobuf:"bytes,21,opt,name=bound_account,json=boundAccount,proto3" json:"bound_account,omitempty"`
 }
 
 func (m *MsgUpdateDidPayload) Reset()         { *m = MsgUpdateDidPayload{} }
@@ -429,37 +589,42 @@ func (m *MsgUpdateDidPayload) GetVerificationMethod() []*VerificationMethod {
 	return nil
 }
 
-func (m *MsgUpdateDidPayload) GetAuthentication() []string {
+// Deprecated: Do not use.
+func (m *MsgUpdateDidPayload) GetLegacyAuthentication() []string {
 	if m != nil {
-		return m.Authentication
+		return m.LegacyAuthentication
 	}
 	return nil
 }
 
-func (m *MsgUpdateDidPayload) GetAssertionMethod() []string {
+// Deprecated: Do not use.
+func (m *MsgUpdateDidPayload) GetLegacyAssertionMethod() []string {
 	if m != nil {
-		return m.AssertionMethod
+		return m.LegacyAssertionMethod
 	}
 	return nil
 }
 
-func (m *MsgUpdateDidPayload) GetCapabilityInvocation() []string {
+// Deprecated: Do not use.
+func (m *MsgUpdateDidPayload) GetLegacyCapabilityInvocation() []string {
 	if m != nil {
-		return m.CapabilityInvocation
+		return m.LegacyCapabilityInvocation
 	}
 	return nil
 }
 
-func (m *MsgUpdateDidPayload) GetCapabilityDelegation() []string {
+// Deprecated: Do not use.
+func (m *MsgUpdateDidPayload) GetLegacyCapabilityDelegation() []string {
 	if m != nil {
-		return m.CapabilityDelegation
+		return m.LegacyCapabilityDelegation
 	}
 	return nil
 }
 
-func (m *MsgUpdateDidPayload) GetKeyAgreement() []string {
+// Deprecated: Do not use.
+func (m *MsgUpdateDidPayload) GetLegacyKeyAgreement() []string {
 	if m != nil {
-		return m.KeyAgreement
+		return m.LegacyKeyAgreement
 	}
 	return nil
 }
@@ -485,6 +650,69 @@ func (m *MsgUpdateDidPayload) GetVersionId() string {
 	return ""
 }
 
+func (m *MsgUpdateDidPayload) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgUpdateDidPayload) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgUpdateDidPayload) GetExpiryTime() uint64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *MsgUpdateDidPayload) GetAuthentication() []*VerificationRelationship {
+	if m != nil {
+		return m.Authentication
+	}
+	return nil
+}
+
+func (m *MsgUpdateDidPayload) GetAssertionMethod() []*VerificationRelationship {
+	if m != nil {
+		return m.AssertionMethod
+	}
+	return nil
+}
+
+func (m *MsgUpdateDidPayload) GetCapabilityInvocation() []*VerificationRelationship {
+	if m != nil {
+		return m.CapabilityInvocation
+	}
+	return nil
+}
+
+func (m *MsgUpdateDidPayload) GetCapabilityDelegation() []*VerificationRelationship {
+	if m != nil {
+		return m.CapabilityDelegation
+	}
+	return nil
+}
+
+func (m *MsgUpdateDidPayload) GetKeyAgreement() []*VerificationRelationship {
+	if m != nil {
+		return m.KeyAgreement
+	}
+	return nil
+}
+
+func (m *MsgUpdateDidPayload) GetBoundAccount() string {
+	if m != nil {
+		return m.BoundAccount
+	}
+	return ""
+}
+
 type MsgUpdateDidResponse struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -529,7 +757,151 @@ func (m *MsgUpdateDidResponse) GetId() string {
 	return ""
 }
 
+// MsgSendResolveDid sends a request to resolve a DID on the chain connected through the source channel.
+// The resolved DID Doc is returned in the packet acknowledgement.
+type MsgSendResolveDid struct {
+	// Account sending the packet
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	SourcePort    string `protobuf:"bytes,2,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel string `protobuf:"bytes,3,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// DID to resolve on the counterparty chain
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// Timeout height on the counterparty chain. Zero disables the height timeout.
+	TimeoutRevisionNumber uint64 `protobuf:"varint,5,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	TimeoutRevisionHeight uint64 `protobuf:"varint,6,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	// Timeout timestamp in nanoseconds on the counterparty chain. Zero disables the timestamp timeout.
+	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *MsgSendResolveDid) Reset()         { *m = MsgSendResolveDid{} }
+func (m *MsgSendResolveDid) String() string { return proto.CompactTextString(m) }
+func (*MsgSendResolveDid) ProtoMessage()    {}
+func (*MsgSendResolveDid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef903f85b95effd2, []int{7}
+}
+func (m *MsgSendResolveDid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendResolveDid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendResolveDid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendResolveDid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendResolveDid.Merge(m, src)
+}
+func (m *MsgSendResolveDid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendResolveDid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendResolveDid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendResolveDid proto.InternalMessageInfo
+
+func (m *MsgSendResolveDid) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSendResolveDid) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *MsgSendResolveDid) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *MsgSendResolveDid) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MsgSendResolveDid) GetTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (m *MsgSendResolveDid) GetTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (m *MsgSendResolveDid) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+type MsgSendResolveDidResponse struct {
+	// Sequence of the sent packet
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgSendResolveDidResponse) Reset()         { *m = MsgSendResolveDidResponse{} }
+func (m *MsgSendResolveDidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendResolveDidResponse) ProtoMessage()    {}
+func (*MsgSendResolveDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef903f85b95effd2, []int{8}
+}
+func (m *MsgSendResolveDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendResolveDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendResolveDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendResolveDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendResolveDidResponse.Merge(m, src)
+}
+func (m *MsgSendResolveDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendResolveDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendResolveDidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendResolveDidResponse proto.InternalMessageInfo
+
+func (m *MsgSendResolveDidResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cheqdid.cheqdnode.cheqd.v1.SignMode", SignMode_name, SignMode_value)
 	proto.RegisterType((*MsgCreateDid)(nil), "cheqdid.cheqdnode.cheqd.v1.MsgCreateDid")
 	proto.RegisterType((*MsgUpdateDid)(nil), "cheqdid.cheqdnode.cheqd.v1.MsgUpdateDid")
 	proto.RegisterType((*SignInfo)(nil), "cheqdid.cheqdnode.cheqd.v1.SignInfo")
@@ -537,50 +909,81 @@ func init() {
 	proto.RegisterType((*MsgCreateDidResponse)(nil), "cheqdid.cheqdnode.cheqd.v1.MsgCreateDidResponse")
 	proto.RegisterType((*MsgUpdateDidPayload)(nil), "cheqdid.cheqdnode.cheqd.v1.MsgUpdateDidPayload")
 	proto.RegisterType((*MsgUpdateDidResponse)(nil), "cheqdid.cheqdnode.cheqd.v1.MsgUpdateDidResponse")
+	proto.RegisterType((*MsgSendResolveDid)(nil), "cheqdid.cheqdnode.cheqd.v1.MsgSendResolveDid")
+	proto.RegisterType((*MsgSendResolveDidResponse)(nil), "cheqdid.cheqdnode.cheqd.v1.MsgSendResolveDidResponse")
 }
 
 func init() { proto.RegisterFile("cheqd/v1/tx.proto", fileDescriptor_ef903f85b95effd2) }
 
 var fileDescriptor_ef903f85b95effd2 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xbb, 0x6e, 0x13, 0x41,
-	0x14, 0xcd, 0xda, 0x21, 0x8e, 0xaf, 0x93, 0x00, 0x93, 0x80, 0x06, 0x0b, 0x56, 0x91, 0x83, 0x22,
-	0xa7, 0x60, 0x97, 0x24, 0xb4, 0x14, 0x21, 0x69, 0x2c, 0x14, 0x09, 0x2d, 0x82, 0x82, 0x82, 0xd5,
-	0xd8, 0x73, 0xb3, 0x1e, 0x65, 0x33, 0x63, 0x76, 0xc6, 0x26, 0xfe, 0x00, 0x7a, 0xfe, 0x80, 0x86,
-	0x8f, 0xa1, 0x4c, 0x07, 0x25, 0x4a, 0x7e, 0x04, 0x79, 0xf6, 0x61, 0xcb, 0x79, 0x60, 0x21, 0x51,
-	0x41, 0xe3, 0xc7, 0xb9, 0xe7, 0xdc, 0x3d, 0x73, 0xf7, 0x68, 0x2e, 0xdc, 0xed, 0x74, 0xf1, 0x03,
-	0xf7, 0x07, 0xdb, 0xbe, 0x39, 0xf5, 0x7a, 0x89, 0x32, 0x8a, 0xd4, 0x2d, 0x24, 0xb8, 0x67, 0xbf,
-	0xa5, 0xe2, 0x98, 0xfe, 0xf2, 0x06, 0xdb, 0xf5, 0x07, 0x91, 0x52, 0x51, 0x8c, 0xbe, 0x65, 0xb6,
-	0xfb, 0x47, 0x3e, 0x93, 0xc3, 0x54, 0x56, 0x27, 0x45, 0xa7, 0x91, 0xd6, 0x62, 0x8d, 0x2f, 0x0e,
-	0x2c, 0x1d, 0xea, 0x68, 0x3f, 0x41, 0x66, 0xf0, 0x40, 0x70, 0xd2, 0x82, 0x4a, 0x8f, 0x0d, 0x63,
-	0xc5, 0x38, 0x75, 0xd6, 0x9d, 0x66, 0x6d, 0xc7, 0xf7, 0xae, 0x7f, 0x9a, 0x37, 0x29, 0x7d, 0x95,
-	0xca, 0x82, 0x5c, 0x4f, 0x0e, 0x00, 0xb4, 0x88, 0x24, 0x33, 0xfd, 0x04, 0x35, 0x2d, 0xad, 0x97,
-	0x9b, 0xb5, 0x9d, 0xc7, 0x37, 0x75, 0x7b, 0x2d, 0x22, 0xd9, 0x92, 0x47, 0x2a, 0x98, 0xd0, 0xe5,
-	0x0e, 0xdf, 0xf4, 0xf8, 0x9f, 0x3a, 0x2c, 0xa4, 0x7f, 0xc9, 0xe1, 0x7b, 0x58, 0xcc, 0x71, 0xf2,
-	0x0c, 0xee, 0x0f, 0x30, 0x11, 0x47, 0xa2, 0xc3, 0x8c, 0x50, 0x32, 0x3c, 0x41, 0xd3, 0x55, 0x3c,
-	0x14, 0xa9, 0xd7, 0x6a, 0xb0, 0x36, 0x59, 0x3d, 0xb4, 0xc5, 0x16, 0x27, 0x0f, 0xa1, 0x5a, 0xf4,
-	0xa3, 0x25, 0x4b, 0x1c, 0x03, 0x8d, 0x4f, 0xf3, 0xb0, 0x7a, 0xc5, 0xa0, 0x09, 0x85, 0x4a, 0x47,
-	0x49, 0x83, 0xa7, 0x86, 0x3a, 0xeb, 0xe5, 0x66, 0x35, 0xc8, 0xff, 0x92, 0x15, 0x28, 0x09, 0x9e,
-	0x35, 0x2a, 0x09, 0x4e, 0x5c, 0x80, 0x51, 0x29, 0x51, 0x71, 0x8c, 0x09, 0x2d, 0x5b, 0xf2, 0x04,
-	0x42, 0x42, 0x58, 0xbd, 0xc2, 0x35, 0x9d, 0xb7, 0x03, 0xf1, 0x6e, 0x1a, 0xc8, 0xdb, 0x4b, 0xc7,
-	0x09, 0xc8, 0xe5, 0x23, 0x92, 0x4d, 0x58, 0x61, 0x7d, 0xd3, 0x45, 0x69, 0x32, 0x9c, 0xde, 0xb2,
-	0x26, 0xa6, 0x50, 0xb2, 0x05, 0x77, 0x98, 0xd6, 0x98, 0x4c, 0xba, 0x58, 0xb0, 0xcc, 0xdb, 0x05,
-	0x9e, 0xb5, 0xdc, 0x85, 0x7b, 0x1d, 0xd6, 0x63, 0x6d, 0x11, 0x0b, 0x33, 0x0c, 0x85, 0x1c, 0xa8,
-	0xac, 0x73, 0xc5, 0xf2, 0xd7, 0xc6, 0xc5, 0x56, 0x51, 0x9b, 0x12, 0x71, 0x8c, 0x31, 0x4a, 0x45,
-	0x8b, 0xd3, 0xa2, 0x83, 0xa2, 0x46, 0x36, 0x60, 0xf9, 0x18, 0x87, 0x21, 0x8b, 0x12, 0xc4, 0x13,
-	0x94, 0x86, 0x56, 0x2d, 0x79, 0xe9, 0x18, 0x87, 0x7b, 0x39, 0x46, 0x1a, 0xb0, 0xcc, 0x62, 0xad,
-	0xc2, 0x63, 0xa9, 0x3e, 0xca, 0x90, 0x69, 0x0a, 0x96, 0x54, 0x1b, 0x81, 0x2f, 0x47, 0xd8, 0x9e,
-	0x26, 0xcf, 0xa1, 0xa2, 0x31, 0x19, 0x88, 0x0e, 0xd2, 0x9a, 0x1d, 0xed, 0xc6, 0x8d, 0x59, 0x4b,
-	0xa9, 0x41, 0xae, 0x69, 0x6c, 0xc2, 0xda, 0x64, 0x0c, 0x02, 0xd4, 0x3d, 0x25, 0x35, 0x66, 0x6f,
-	0xdb, 0xc9, 0xdf, 0x76, 0xe3, 0x6b, 0x9a, 0x97, 0xe9, 0xd8, 0xff, 0xcf, 0xcb, 0xbf, 0x95, 0x17,
-	0xf2, 0x08, 0x60, 0x80, 0x89, 0x1e, 0x8d, 0x46, 0x70, 0xba, 0x94, 0x5e, 0x2b, 0x19, 0xd2, 0xe2,
-	0x59, 0x9c, 0x8a, 0x94, 0x5c, 0x17, 0xa7, 0x9d, 0xef, 0x0e, 0x94, 0x0f, 0x75, 0x44, 0x22, 0xa8,
-	0x8e, 0xd7, 0x44, 0x73, 0xd6, 0xad, 0x50, 0x7f, 0x3a, 0x2b, 0xb3, 0x30, 0x10, 0x41, 0x75, 0x7c,
-	0xdb, 0x37, 0x67, 0xbd, 0xdc, 0x7f, 0xfb, 0xa0, 0x4b, 0x27, 0x7d, 0xb1, 0xff, 0xed, 0xdc, 0x75,
-	0xce, 0xce, 0x5d, 0xe7, 0xe7, 0xb9, 0xeb, 0x7c, 0xbe, 0x70, 0xe7, 0xce, 0x2e, 0xdc, 0xb9, 0x1f,
-	0x17, 0xee, 0xdc, 0xbb, 0xad, 0x48, 0x98, 0x6e, 0xbf, 0xed, 0x75, 0xd4, 0x89, 0x9f, 0x6e, 0x4d,
-	0xfb, 0xf9, 0x64, 0xd4, 0xd4, 0x3f, 0xcd, 0x20, 0x33, 0xec, 0xa1, 0x6e, 0x2f, 0xd8, 0x45, 0xba,
-	0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x8a, 0x76, 0x24, 0xa8, 0x07, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xd1, 0x6e, 0xe3, 0x44,
+	0x14, 0xad, 0xd3, 0x6e, 0x53, 0xdf, 0x36, 0xd9, 0x64, 0x9a, 0xee, 0x7a, 0xa3, 0x25, 0x44, 0x29,
+	0xa0, 0x00, 0xda, 0x64, 0xb7, 0x5b, 0x58, 0x09, 0x89, 0x87, 0xb4, 0x45, 0x10, 0x56, 0x29, 0x2b,
+	0x77, 0x41, 0x5a, 0x84, 0xb0, 0x1c, 0xfb, 0xd6, 0x19, 0x35, 0x99, 0xc9, 0xda, 0x93, 0xd0, 0xfc,
+	0x05, 0x9f, 0xc0, 0xe7, 0xf0, 0x46, 0x1f, 0x79, 0x44, 0xed, 0x07, 0xf0, 0xce, 0x13, 0xf2, 0x78,
+	0xec, 0xba, 0x6d, 0xda, 0x06, 0x4a, 0x57, 0x42, 0xda, 0x97, 0xc6, 0x73, 0xee, 0xb9, 0xf7, 0xce,
+	0x8c, 0x8f, 0x4f, 0x67, 0xa0, 0xe8, 0xf4, 0xf0, 0xb5, 0xdb, 0x1c, 0x3f, 0x69, 0x8a, 0xc3, 0xc6,
+	0xd0, 0xe7, 0x82, 0x93, 0xb2, 0x84, 0xa8, 0xdb, 0x90, 0xbf, 0x8c, 0xbb, 0x18, 0x3d, 0x35, 0xc6,
+	0x4f, 0xca, 0x0f, 0x3c, 0xce, 0xbd, 0x3e, 0x36, 0x25, 0xb3, 0x3b, 0xda, 0x6f, 0xda, 0x6c, 0x12,
+	0xa5, 0x95, 0x49, 0x52, 0x29, 0xcc, 0x95, 0x58, 0xed, 0x2f, 0x0d, 0x56, 0x3a, 0x81, 0xb7, 0xed,
+	0xa3, 0x2d, 0x70, 0x87, 0xba, 0xa4, 0x0d, 0xd9, 0xa1, 0x3d, 0xe9, 0x73, 0xdb, 0x35, 0xb4, 0xaa,
+	0x56, 0x5f, 0xde, 0x68, 0x36, 0x2e, 0xef, 0xd6, 0x48, 0xa7, 0xbe, 0x88, 0xd2, 0xcc, 0x38, 0x9f,
+	0xec, 0x00, 0x04, 0xd4, 0x63, 0xb6, 0x18, 0xf9, 0x18, 0x18, 0x99, 0xea, 0x7c, 0x7d, 0x79, 0xe3,
+	0xbd, 0xab, 0xaa, 0xed, 0x51, 0x8f, 0xb5, 0xd9, 0x3e, 0x37, 0x53, 0x79, 0xa4, 0x05, 0x7a, 0x38,
+	0xb2, 0x06, 0xdc, 0x45, 0x63, 0xbe, 0xaa, 0xd5, 0xf3, 0xd7, 0x17, 0xe9, 0x70, 0x17, 0xcd, 0xa5,
+	0x40, 0x3d, 0x91, 0x7b, 0xb0, 0x18, 0x3e, 0xa3, 0x6f, 0x2c, 0x54, 0xb5, 0xba, 0x6e, 0xaa, 0x51,
+	0xbc, 0xf8, 0x6f, 0x87, 0xee, 0xbf, 0x5d, 0x7c, 0x92, 0xfa, 0xff, 0x5b, 0xfc, 0x8f, 0xb0, 0x14,
+	0xb7, 0x24, 0x9b, 0x70, 0x6f, 0x8c, 0x3e, 0xdd, 0xa7, 0x8e, 0x2d, 0x28, 0x67, 0xd6, 0x00, 0x45,
+	0x8f, 0xbb, 0x16, 0x8d, 0xb6, 0x41, 0x37, 0x4b, 0xe9, 0x68, 0x47, 0x06, 0xdb, 0x2e, 0x79, 0x08,
+	0x7a, 0x32, 0x55, 0x23, 0x23, 0x89, 0xa7, 0x40, 0xed, 0x48, 0x87, 0xd5, 0x29, 0xf2, 0x20, 0x06,
+	0x64, 0x1d, 0xce, 0x04, 0x1e, 0x0a, 0x43, 0xab, 0xce, 0xd7, 0x75, 0x33, 0x1e, 0x92, 0x3c, 0x64,
+	0xa8, 0xab, 0x0a, 0x65, 0xa8, 0x4b, 0x2a, 0x00, 0x61, 0xc8, 0xe7, 0xfd, 0x3e, 0xfa, 0xc6, 0xbc,
+	0x24, 0xa7, 0x10, 0x62, 0xc1, 0xea, 0x94, 0x59, 0x1b, 0x0b, 0x72, 0xaf, 0x1b, 0x57, 0x6d, 0xd3,
+	0x77, 0x17, 0x96, 0x63, 0x92, 0x8b, 0x4b, 0x24, 0xcf, 0x60, 0xad, 0x8f, 0x9e, 0xed, 0x4c, 0x2c,
+	0x7b, 0x24, 0x7a, 0xc8, 0x84, 0x0a, 0x1b, 0x77, 0xc2, 0xb9, 0x6c, 0x65, 0x0c, 0xcd, 0x2c, 0x45,
+	0x84, 0xd6, 0x99, 0x38, 0xf9, 0x0c, 0xee, 0xc7, 0x89, 0x41, 0x80, 0x7e, 0x7a, 0x76, 0x8b, 0x49,
+	0xaa, 0xaa, 0xdd, 0x8a, 0x19, 0xaa, 0xe9, 0x0e, 0x3c, 0x54, 0xb9, 0x8e, 0x3d, 0xb4, 0xbb, 0xb4,
+	0x4f, 0xc5, 0xc4, 0xa2, 0x6c, 0xcc, 0x55, 0xef, 0x6c, 0x52, 0xa0, 0x1c, 0xf1, 0xb6, 0x13, 0x5a,
+	0x3b, 0x61, 0x4d, 0xaf, 0xe2, 0x62, 0x88, 0xc9, 0x2a, 0x4b, 0x97, 0x57, 0xd9, 0x49, 0x58, 0x64,
+	0x13, 0xd4, 0xfa, 0xac, 0x03, 0x9c, 0x58, 0xb6, 0xe7, 0x23, 0x0e, 0x90, 0x09, 0x43, 0x4f, 0xb2,
+	0x49, 0x14, 0x7f, 0x8e, 0x93, 0x56, 0x1c, 0x25, 0x35, 0xc8, 0xd9, 0xfd, 0x80, 0x5b, 0x07, 0x8c,
+	0xff, 0xc4, 0x2c, 0x3b, 0x30, 0x40, 0xbe, 0xba, 0xe5, 0x10, 0x7c, 0x1e, 0x62, 0xad, 0x80, 0x7c,
+	0x0e, 0xd9, 0x00, 0xfd, 0x31, 0x75, 0xd0, 0x58, 0x96, 0xef, 0x6b, 0xfd, 0x4a, 0x59, 0x47, 0x54,
+	0x33, 0xce, 0x21, 0x0f, 0x60, 0xc9, 0xe9, 0xd9, 0x94, 0x85, 0x12, 0x5d, 0x91, 0x82, 0xc9, 0xca,
+	0x71, 0xdb, 0x25, 0xeb, 0x90, 0xc3, 0xc3, 0x21, 0xf5, 0x27, 0x56, 0x0f, 0xa9, 0xd7, 0x13, 0x46,
+	0xae, 0xaa, 0xd5, 0x17, 0xcc, 0x95, 0x08, 0xfc, 0x4a, 0x62, 0xe4, 0x5d, 0x58, 0x56, 0x24, 0x41,
+	0x07, 0x68, 0xe4, 0x25, 0x05, 0x22, 0xe8, 0x25, 0x1d, 0x20, 0xf9, 0x01, 0xf2, 0xe7, 0xde, 0xf9,
+	0x5d, 0x39, 0xcd, 0xcd, 0x59, 0x65, 0x65, 0x62, 0x5f, 0xfe, 0x06, 0x3d, 0x3a, 0x34, 0xcf, 0xd5,
+	0x22, 0x16, 0x14, 0x2e, 0x08, 0xa3, 0x70, 0x83, 0xfa, 0x77, 0xed, 0x73, 0x22, 0xa2, 0xb0, 0x36,
+	0x5d, 0x3d, 0xc5, 0x1b, 0x74, 0x29, 0x39, 0xd3, 0x94, 0x76, 0xb6, 0x55, 0x4a, 0x62, 0xe4, 0xbf,
+	0x69, 0x95, 0x92, 0xe3, 0x2b, 0xc8, 0x9d, 0xd5, 0xe1, 0xea, 0x0d, 0x5a, 0xac, 0x1c, 0xa4, 0x35,
+	0xbb, 0x0e, 0xb9, 0x2e, 0x1f, 0x31, 0xd7, 0xb2, 0x1d, 0x87, 0x8f, 0x98, 0x30, 0x4a, 0x52, 0x55,
+	0x2b, 0x12, 0x6c, 0x45, 0x58, 0xed, 0x03, 0x28, 0xa5, 0x1d, 0xcd, 0xc4, 0x60, 0xc8, 0x59, 0x80,
+	0xca, 0xb8, 0xb4, 0xd8, 0xb8, 0x6a, 0x7f, 0x46, 0xd6, 0x77, 0xfe, 0x9f, 0xc3, 0x5b, 0xeb, 0x7b,
+	0x6b, 0x7d, 0x37, 0xb7, 0xbe, 0x77, 0x00, 0xc6, 0xe8, 0x07, 0x94, 0xa7, 0xcc, 0x4f, 0x57, 0x48,
+	0xdb, 0x3d, 0xe3, 0x8c, 0xb9, 0x6b, 0x9c, 0x31, 0x7f, 0xbd, 0x33, 0xde, 0x9d, 0xc1, 0x19, 0x0b,
+	0xb7, 0xec, 0x8c, 0xc5, 0x37, 0xe2, 0x8c, 0xe4, 0xcd, 0x39, 0xe3, 0xea, 0xed, 0x3b, 0x63, 0xe9,
+	0xf6, 0x9c, 0x71, 0xed, 0x52, 0x67, 0x4c, 0x0c, 0xef, 0x52, 0x67, 0xfc, 0x25, 0x03, 0xc5, 0x4e,
+	0xe0, 0xed, 0x21, 0x0b, 0x39, 0xbc, 0x3f, 0x0e, 0xd9, 0xa9, 0x23, 0xaa, 0x96, 0x3e, 0xa2, 0x86,
+	0x5a, 0x0c, 0xf8, 0xc8, 0x77, 0xd0, 0x1a, 0x72, 0x5f, 0x28, 0x7b, 0x84, 0x08, 0x7a, 0xc1, 0x7d,
+	0x41, 0xde, 0x87, 0xbc, 0x22, 0x38, 0x3d, 0x9b, 0x31, 0xec, 0xcb, 0x33, 0xb2, 0x6e, 0xe6, 0x22,
+	0x74, 0x3b, 0x02, 0xd5, 0x2c, 0x16, 0x12, 0x77, 0xfd, 0x14, 0xee, 0x87, 0xe2, 0xe6, 0x23, 0x61,
+	0xf9, 0x38, 0xa6, 0xf2, 0x5b, 0x62, 0xa3, 0x41, 0x17, 0x7d, 0xe3, 0x8e, 0xd4, 0xfb, 0x9a, 0x0a,
+	0x9b, 0x2a, 0xba, 0x2b, 0x83, 0x53, 0xf3, 0xd4, 0xa7, 0xb4, 0x38, 0x35, 0x4f, 0x7d, 0x53, 0x1f,
+	0x43, 0x31, 0xce, 0x0b, 0x7f, 0x03, 0x61, 0x0f, 0x86, 0x46, 0x56, 0x66, 0x14, 0x54, 0xe0, 0x65,
+	0x8c, 0xd7, 0x9e, 0xc1, 0x83, 0x0b, 0x3b, 0x94, 0xec, 0x67, 0x19, 0x96, 0x02, 0x7c, 0x3d, 0x42,
+	0xe6, 0xa0, 0xdc, 0xab, 0x05, 0x33, 0x19, 0x7f, 0xf4, 0x34, 0x3a, 0xd0, 0xcb, 0x43, 0x7f, 0x09,
+	0x0a, 0x7b, 0xed, 0x2f, 0x77, 0xad, 0xce, 0x37, 0x3b, 0x5f, 0x58, 0x5b, 0xed, 0xdd, 0x96, 0xf9,
+	0xaa, 0x30, 0x47, 0x8a, 0x90, 0x3b, 0x45, 0xbf, 0xde, 0xde, 0x2b, 0x68, 0x1b, 0xbf, 0x65, 0x60,
+	0xbe, 0x13, 0x78, 0xc4, 0x03, 0xfd, 0xf4, 0x0e, 0x58, 0x9f, 0xf5, 0xca, 0x57, 0x7e, 0x3c, 0x2b,
+	0x33, 0x59, 0x81, 0x07, 0xfa, 0xe9, 0x7d, 0xab, 0x3e, 0xeb, 0xf5, 0xaa, 0xfc, 0x78, 0x56, 0x66,
+	0xd2, 0x68, 0x0c, 0xf9, 0x73, 0x32, 0x7b, 0x74, 0x4d, 0x8d, 0xb3, 0xf4, 0xf2, 0x27, 0xff, 0x88,
+	0x1e, 0xf7, 0xdd, 0xda, 0xfe, 0xf5, 0xb8, 0xa2, 0x1d, 0x1d, 0x57, 0xb4, 0x3f, 0x8e, 0x2b, 0xda,
+	0xcf, 0x27, 0x95, 0xb9, 0xa3, 0x93, 0xca, 0xdc, 0xef, 0x27, 0x95, 0xb9, 0xef, 0x3f, 0xf4, 0xa8,
+	0xe8, 0x8d, 0xba, 0x0d, 0x87, 0x0f, 0x9a, 0xd1, 0x55, 0x5c, 0xfe, 0x7d, 0x14, 0x56, 0x6e, 0x1e,
+	0x2a, 0x48, 0x4c, 0x86, 0x18, 0x74, 0x17, 0xe5, 0xed, 0xfc, 0xe9, 0xdf, 0x03, 0x00, 0xa0, 0x99,
+	0xbd, 0x16, 0xfd, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -597,6 +1000,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateDid(ctx context.Context, in *MsgCreateDid, opts ...grpc.CallOption) (*MsgCreateDidResponse, error)
 	UpdateDid(ctx context.Context, in *MsgUpdateDid, opts ...grpc.CallOption) (*MsgUpdateDidResponse, error)
+	SendResolveDid(ctx context.Context, in *MsgSendResolveDid, opts ...grpc.CallOption) (*MsgSendResolveDidResponse, error)
 }
 
 type msgClient struct {
@@ -625,10 +1029,20 @@ func (c *msgClient) UpdateDid(ctx context.Context, in *MsgUpdateDid, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) SendResolveDid(ctx context.Context, in *MsgSendResolveDid, opts ...grpc.CallOption) (*MsgSendResolveDidResponse, error) {
+	out := new(MsgSendResolveDidResponse)
+	err := c.cc.Invoke(ctx, "/cheqdid.cheqdnode.cheqd.v1.Msg/SendResolveDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDid(context.Context, *MsgCreateDid) (*MsgCreateDidResponse, error)
 	UpdateDid(context.Context, *MsgUpdateDid) (*MsgUpdateDidResponse, error)
+	SendResolveDid(context.Context, *MsgSendResolveDid) (*MsgSendResolveDidResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -641,6 +1055,9 @@ func (*UnimplementedMsgServer) CreateDid(ctx context.Context, req *MsgCreateDid)
 func (*UnimplementedMsgServer) UpdateDid(ctx context.Context, req *MsgUpdateDid) (*MsgUpdateDidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDid not implemented")
 }
+func (*UnimplementedMsgServer) SendResolveDid(ctx context.Context, req *MsgSendResolveDid) (*MsgSendResolveDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendResolveDid not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -682,6 +1099,24 @@ func _Msg_UpdateDid_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SendResolveDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendResolveDid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendResolveDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cheqdid.cheqdnode.cheqd.v1.Msg/SendResolveDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendResolveDid(ctx, req.(*MsgSendResolveDid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cheqdid.cheqdnode.cheqd.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -694,6 +1129,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDid",
 			Handler:    _Msg_UpdateDid_Handler,
 		},
+		{
+			MethodName: "SendResolveDid",
+			Handler:    _Msg_SendResolveDid_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cheqd/v1/tx.proto",
@@ -719,6 +1158,18 @@ func (m *MsgCreateDid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SignMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignMode))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Signatures) > 0 {
 		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -768,6 +1219,18 @@ func (m *MsgUpdateDid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SignMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignMode))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Signatures) > 0 {
 		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -854,10 +1317,19 @@ func (m *MsgCreateDidPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Service) > 0 {
-		for iNdEx := len(m.Service) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BoundAccount) > 0 {
+		i -= len(m.BoundAccount)
+		copy(dAtA[i:], m.BoundAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BoundAccount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.KeyAgreement) > 0 {
+		for iNdEx := len(m.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Service[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.KeyAgreement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -865,10 +1337,105 @@ func (m *MsgCreateDidPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.AlsoKnownAs) > 0 {
+	if len(m.CapabilityDelegation) > 0 {
+		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapabilityDelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for iNdEx := len(m.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapabilityInvocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for iNdEx := len(m.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssertionMethod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Authentication) > 0 {
+		for iNdEx := len(m.Authentication) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authentication[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Service) > 0 {
+		for iNdEx := len(m.Service) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Service[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AlsoKnownAs) > 0 {
 		for iNdEx := len(m.AlsoKnownAs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AlsoKnownAs[iNdEx])
 			copy(dAtA[i:], m.AlsoKnownAs[iNdEx])
@@ -877,47 +1444,47 @@ func (m *MsgCreateDidPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x52
 		}
 	}
-	if len(m.KeyAgreement) > 0 {
-		for iNdEx := len(m.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeyAgreement[iNdEx])
-			copy(dAtA[i:], m.KeyAgreement[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.KeyAgreement[iNdEx])))
+	if len(m.LegacyKeyAgreement) > 0 {
+		for iNdEx := len(m.LegacyKeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyKeyAgreement[iNdEx])
+			copy(dAtA[i:], m.LegacyKeyAgreement[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyKeyAgreement[iNdEx])))
 			i--
 			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.CapabilityDelegation) > 0 {
-		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CapabilityDelegation[iNdEx])
-			copy(dAtA[i:], m.CapabilityDelegation[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CapabilityDelegation[iNdEx])))
+	if len(m.LegacyCapabilityDelegation) > 0 {
+		for iNdEx := len(m.LegacyCapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyCapabilityDelegation[iNdEx])
+			copy(dAtA[i:], m.LegacyCapabilityDelegation[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyCapabilityDelegation[iNdEx])))
 			i--
 			dAtA[i] = 0x42
 		}
 	}
-	if len(m.CapabilityInvocation) > 0 {
-		for iNdEx := len(m.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CapabilityInvocation[iNdEx])
-			copy(dAtA[i:], m.CapabilityInvocation[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CapabilityInvocation[iNdEx])))
+	if len(m.LegacyCapabilityInvocation) > 0 {
+		for iNdEx := len(m.LegacyCapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyCapabilityInvocation[iNdEx])
+			copy(dAtA[i:], m.LegacyCapabilityInvocation[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyCapabilityInvocation[iNdEx])))
 			i--
 			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.AssertionMethod) > 0 {
-		for iNdEx := len(m.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AssertionMethod[iNdEx])
-			copy(dAtA[i:], m.AssertionMethod[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AssertionMethod[iNdEx])))
+	if len(m.LegacyAssertionMethod) > 0 {
+		for iNdEx := len(m.LegacyAssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyAssertionMethod[iNdEx])
+			copy(dAtA[i:], m.LegacyAssertionMethod[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyAssertionMethod[iNdEx])))
 			i--
 			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Authentication) > 0 {
-		for iNdEx := len(m.Authentication) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Authentication[iNdEx])
-			copy(dAtA[i:], m.Authentication[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Authentication[iNdEx])))
+	if len(m.LegacyAuthentication) > 0 {
+		for iNdEx := len(m.LegacyAuthentication) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyAuthentication[iNdEx])
+			copy(dAtA[i:], m.LegacyAuthentication[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyAuthentication[iNdEx])))
 			i--
 			dAtA[i] = 0x2a
 		}
@@ -1014,6 +1581,112 @@ func (m *MsgUpdateDidPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BoundAccount) > 0 {
+		i -= len(m.BoundAccount)
+		copy(dAtA[i:], m.BoundAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BoundAccount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.KeyAgreement) > 0 {
+		for iNdEx := len(m.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAgreement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapabilityDelegation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for iNdEx := len(m.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapabilityInvocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for iNdEx := len(m.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssertionMethod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Authentication) > 0 {
+		for iNdEx := len(m.Authentication) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authentication[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -1044,47 +1717,47 @@ func (m *MsgUpdateDidPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x52
 		}
 	}
-	if len(m.KeyAgreement) > 0 {
-		for iNdEx := len(m.KeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeyAgreement[iNdEx])
-			copy(dAtA[i:], m.KeyAgreement[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.KeyAgreement[iNdEx])))
+	if len(m.LegacyKeyAgreement) > 0 {
+		for iNdEx := len(m.LegacyKeyAgreement) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyKeyAgreement[iNdEx])
+			copy(dAtA[i:], m.LegacyKeyAgreement[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyKeyAgreement[iNdEx])))
 			i--
 			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.CapabilityDelegation) > 0 {
-		for iNdEx := len(m.CapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CapabilityDelegation[iNdEx])
-			copy(dAtA[i:], m.CapabilityDelegation[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CapabilityDelegation[iNdEx])))
+	if len(m.LegacyCapabilityDelegation) > 0 {
+		for iNdEx := len(m.LegacyCapabilityDelegation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyCapabilityDelegation[iNdEx])
+			copy(dAtA[i:], m.LegacyCapabilityDelegation[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyCapabilityDelegation[iNdEx])))
 			i--
 			dAtA[i] = 0x42
 		}
 	}
-	if len(m.CapabilityInvocation) > 0 {
-		for iNdEx := len(m.CapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CapabilityInvocation[iNdEx])
-			copy(dAtA[i:], m.CapabilityInvocation[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CapabilityInvocation[iNdEx])))
+	if len(m.LegacyCapabilityInvocation) > 0 {
+		for iNdEx := len(m.LegacyCapabilityInvocation) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyCapabilityInvocation[iNdEx])
+			copy(dAtA[i:], m.LegacyCapabilityInvocation[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyCapabilityInvocation[iNdEx])))
 			i--
 			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.AssertionMethod) > 0 {
-		for iNdEx := len(m.AssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AssertionMethod[iNdEx])
-			copy(dAtA[i:], m.AssertionMethod[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AssertionMethod[iNdEx])))
+	if len(m.LegacyAssertionMethod) > 0 {
+		for iNdEx := len(m.LegacyAssertionMethod) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyAssertionMethod[iNdEx])
+			copy(dAtA[i:], m.LegacyAssertionMethod[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyAssertionMethod[iNdEx])))
 			i--
 			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Authentication) > 0 {
-		for iNdEx := len(m.Authentication) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Authentication[iNdEx])
-			copy(dAtA[i:], m.Authentication[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Authentication[iNdEx])))
+	if len(m.LegacyAuthentication) > 0 {
+		for iNdEx := len(m.LegacyAuthentication) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LegacyAuthentication[iNdEx])
+			copy(dAtA[i:], m.LegacyAuthentication[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyAuthentication[iNdEx])))
 			i--
 			dAtA[i] = 0x2a
 		}
@@ -1161,6 +1834,100 @@ func (m *MsgUpdateDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSendResolveDid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendResolveDid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendResolveDid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendResolveDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendResolveDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendResolveDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1188,6 +1955,13 @@ func (m *MsgCreateDid) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.SignMode != 0 {
+		n += 1 + sovTx(uint64(m.SignMode))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1207,6 +1981,13 @@ func (m *MsgUpdateDid) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.SignMode != 0 {
+		n += 1 + sovTx(uint64(m.SignMode))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1255,32 +2036,32 @@ func (m *MsgCreateDidPayload) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.Authentication) > 0 {
-		for _, s := range m.Authentication {
+	if len(m.LegacyAuthentication) > 0 {
+		for _, s := range m.LegacyAuthentication {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.AssertionMethod) > 0 {
-		for _, s := range m.AssertionMethod {
+	if len(m.LegacyAssertionMethod) > 0 {
+		for _, s := range m.LegacyAssertionMethod {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.CapabilityInvocation) > 0 {
-		for _, s := range m.CapabilityInvocation {
+	if len(m.LegacyCapabilityInvocation) > 0 {
+		for _, s := range m.LegacyCapabilityInvocation {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.CapabilityDelegation) > 0 {
-		for _, s := range m.CapabilityDelegation {
+	if len(m.LegacyCapabilityDelegation) > 0 {
+		for _, s := range m.LegacyCapabilityDelegation {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.KeyAgreement) > 0 {
-		for _, s := range m.KeyAgreement {
+	if len(m.LegacyKeyAgreement) > 0 {
+		for _, s := range m.LegacyKeyAgreement {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
@@ -1297,23 +2078,67 @@ func (m *MsgCreateDidPayload) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *MsgCreateDidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
+	l = len(m.ChainId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgUpdateDidPayload) Size() (n int) {
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryTime))
+	}
+	if len(m.Authentication) > 0 {
+		for _, e := range m.Authentication {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for _, e := range m.AssertionMethod {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for _, e := range m.CapabilityInvocation {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for _, e := range m.CapabilityDelegation {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.KeyAgreement) > 0 {
+		for _, e := range m.KeyAgreement {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.BoundAccount)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDidPayload) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1341,32 +2166,32 @@ func (m *MsgUpdateDidPayload) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.Authentication) > 0 {
-		for _, s := range m.Authentication {
+	if len(m.LegacyAuthentication) > 0 {
+		for _, s := range m.LegacyAuthentication {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.AssertionMethod) > 0 {
-		for _, s := range m.AssertionMethod {
+	if len(m.LegacyAssertionMethod) > 0 {
+		for _, s := range m.LegacyAssertionMethod {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.CapabilityInvocation) > 0 {
-		for _, s := range m.CapabilityInvocation {
+	if len(m.LegacyCapabilityInvocation) > 0 {
+		for _, s := range m.LegacyCapabilityInvocation {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.CapabilityDelegation) > 0 {
-		for _, s := range m.CapabilityDelegation {
+	if len(m.LegacyCapabilityDelegation) > 0 {
+		for _, s := range m.LegacyCapabilityDelegation {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if len(m.KeyAgreement) > 0 {
-		for _, s := range m.KeyAgreement {
+	if len(m.LegacyKeyAgreement) > 0 {
+		for _, s := range m.LegacyKeyAgreement {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
@@ -1387,6 +2212,50 @@ func (m *MsgUpdateDidPayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryTime))
+	}
+	if len(m.Authentication) > 0 {
+		for _, e := range m.Authentication {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AssertionMethod) > 0 {
+		for _, e := range m.AssertionMethod {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CapabilityInvocation) > 0 {
+		for _, e := range m.CapabilityInvocation {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CapabilityDelegation) > 0 {
+		for _, e := range m.CapabilityDelegation {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.KeyAgreement) > 0 {
+		for _, e := range m.KeyAgreement {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.BoundAccount)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1403,6 +2272,52 @@ func (m *MsgUpdateDidResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSendResolveDid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutRevisionNumber))
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutRevisionHeight))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgSendResolveDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1508,6 +2423,57 @@ func (m *MsgCreateDid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignMode", wireType)
+			}
+			m.SignMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignMode |= SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1548,19 +2514,832 @@ func (m *MsgUpdateDid) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDid: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDid: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &MsgUpdateDidPayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &SignInfo{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignMode", wireType)
+			}
+			m.SignMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignMode |= SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDidPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDidPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = append(m.Controller, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethod = append(m.VerificationMethod, &VerificationMethod{})
+			if err := m.VerificationMethod[len(m.VerificationMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAuthentication", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyAuthentication = append(m.LegacyAuthentication, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAssertionMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyAssertionMethod = append(m.LegacyAssertionMethod, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyCapabilityInvocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyCapabilityInvocation = append(m.LegacyCapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyCapabilityDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyCapabilityDelegation = append(m.LegacyCapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyKeyAgreement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyKeyAgreement = append(m.LegacyKeyAgreement, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = append(m.Service, &Service{})
+			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authentication = append(m.Authentication, &VerificationRelationship{})
+			if err := m.Authentication[len(m.Authentication)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssertionMethod = append(m.AssertionMethod, &VerificationRelationship{})
+			if err := m.AssertionMethod[len(m.AssertionMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapabilityInvocation = append(m.CapabilityInvocation, &VerificationRelationship{})
+			if err := m.CapabilityInvocation[len(m.CapabilityInvocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1587,16 +3366,14 @@ func (m *MsgUpdateDid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payload == nil {
-				m.Payload = &MsgUpdateDidPayload{}
-			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CapabilityDelegation = append(m.CapabilityDelegation, &VerificationRelationship{})
+			if err := m.CapabilityDelegation[len(m.CapabilityDelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1623,11 +3400,43 @@ func (m *MsgUpdateDid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &SignInfo{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyAgreement = append(m.KeyAgreement, &VerificationRelationship{})
+			if err := m.KeyAgreement[len(m.KeyAgreement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoundAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1649,7 +3458,7 @@ func (m *MsgUpdateDid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,47 +3481,15 @@ func (m *SignInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1740,7 +3517,7 @@ func (m *SignInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1763,7 +3540,7 @@ func (m *SignInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1786,10 +3563,10 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDidPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDidPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDidPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDidPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1924,7 +3701,7 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAuthentication", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1952,11 +3729,11 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authentication = append(m.Authentication, string(dAtA[iNdEx:postIndex]))
+			m.LegacyAuthentication = append(m.LegacyAuthentication, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAssertionMethod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1984,11 +3761,11 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssertionMethod = append(m.AssertionMethod, string(dAtA[iNdEx:postIndex]))
+			m.LegacyAssertionMethod = append(m.LegacyAssertionMethod, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyCapabilityInvocation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2016,11 +3793,11 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityInvocation = append(m.CapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+			m.LegacyCapabilityInvocation = append(m.LegacyCapabilityInvocation, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyCapabilityDelegation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2048,11 +3825,11 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityDelegation = append(m.CapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+			m.LegacyCapabilityDelegation = append(m.LegacyCapabilityDelegation, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyKeyAgreement", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2080,7 +3857,7 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyAgreement = append(m.KeyAgreement, string(dAtA[iNdEx:postIndex]))
+			m.LegacyKeyAgreement = append(m.LegacyKeyAgreement, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
@@ -2148,59 +3925,41 @@ func (m *MsgCreateDidPayload) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateDidResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDidResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2228,63 +3987,51 @@ func (m *MsgCreateDidResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDidPayload: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDidPayload: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2294,29 +4041,31 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			m.Authentication = append(m.Authentication, &VerificationRelationship{})
+			if err := m.Authentication[len(m.Authentication)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2326,29 +4075,31 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.AssertionMethod = append(m.AssertionMethod, &VerificationRelationship{})
+			if err := m.AssertionMethod[len(m.AssertionMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2358,27 +4109,29 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = append(m.Controller, string(dAtA[iNdEx:postIndex]))
+			m.CapabilityInvocation = append(m.CapabilityInvocation, &VerificationRelationship{})
+			if err := m.CapabilityInvocation[len(m.CapabilityInvocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2405,16 +4158,16 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationMethod = append(m.VerificationMethod, &VerificationMethod{})
-			if err := m.VerificationMethod[len(m.VerificationMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CapabilityDelegation = append(m.CapabilityDelegation, &VerificationRelationship{})
+			if err := m.CapabilityDelegation[len(m.CapabilityDelegation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2424,27 +4177,29 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authentication = append(m.Authentication, string(dAtA[iNdEx:postIndex]))
+			m.KeyAgreement = append(m.KeyAgreement, &VerificationRelationship{})
+			if err := m.KeyAgreement[len(m.KeyAgreement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2472,11 +4227,61 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssertionMethod = append(m.AssertionMethod, string(dAtA[iNdEx:postIndex]))
+			m.BoundAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2504,11 +4309,61 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityInvocation = append(m.CapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendResolveDid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResolveDid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResolveDid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2536,11 +4391,11 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityDelegation = append(m.CapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2568,11 +4423,11 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyAgreement = append(m.KeyAgreement, string(dAtA[iNdEx:postIndex]))
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2600,13 +4455,13 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlsoKnownAs = append(m.AlsoKnownAs, string(dAtA[iNdEx:postIndex]))
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2616,31 +4471,29 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = append(m.Service, &Service{})
-			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutRevisionNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2650,24 +4503,49 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.TimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2689,7 +4567,7 @@ func (m *MsgUpdateDidPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendResolveDidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2712,17 +4590,17 @@ func (m *MsgUpdateDidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendResolveDidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendResolveDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2732,24 +4610,11 @@ func (m *MsgUpdateDidResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
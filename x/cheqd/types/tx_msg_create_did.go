@@ -22,8 +22,19 @@ func (msg *MsgCreateDid) Type() string {
 	return "MsgCreateDid"
 }
 
+// GetSigners returns the account submitting the operation if it's set. Identity operations are authorized
+// by DID signatures, the account makes them executable with authz MsgExec on its behalf.
 func (msg *MsgCreateDid) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{}
+	if msg.Signer == "" {
+		return []sdk.AccAddress{}
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
 }
 
 func (msg *MsgCreateDid) GetSignBytes() []byte {
@@ -34,7 +45,7 @@ func (msg *MsgCreateDid) GetSignBytes() []byte {
 func (msg *MsgCreateDid) ValidateBasic() error {
 	err := msg.Validate(nil)
 	if err != nil {
-		return ErrBasicValidation.Wrap(err.Error())
+		return WithDetails(ErrBasicValidation.Wrap(err.Error()), ValidationErrorDetails(err))
 	}
 
 	return nil
@@ -46,5 +57,7 @@ func (msg MsgCreateDid) Validate(allowedNamespaces []string) error {
 	return validation.ValidateStruct(&msg,
 		validation.Field(&msg.Payload, validation.Required, ValidMsgCreateDidPayloadRule(allowedNamespaces)),
 		validation.Field(&msg.Signatures, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+		validation.Field(&msg.SignMode, IsValidSignMode()),
+		validation.Field(&msg.Signer, validation.When(msg.Signer != "", IsAccAddress())),
 	)
 }
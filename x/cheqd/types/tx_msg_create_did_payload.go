@@ -6,19 +6,27 @@ func (msg *MsgCreateDidPayload) GetSignBytes() []byte {
 	return ModuleCdc.MustMarshal(msg)
 }
 
+// GetSignBytesForMode returns the representation of the payload that is signed in the given sign mode
+func (msg *MsgCreateDidPayload) GetSignBytesForMode(mode SignMode) ([]byte, error) {
+	return getSignBytesForMode(msg, mode)
+}
+
+// ToDid builds the DID Doc. Verification relationships encoded as lists of ids are merged
+// into the lists of VerificationRelationship, the payload itself is kept as signed.
 func (msg *MsgCreateDidPayload) ToDid() Did {
 	return Did{
 		Context:              msg.Context,
 		Id:                   msg.Id,
 		Controller:           msg.Controller,
 		VerificationMethod:   msg.VerificationMethod,
-		Authentication:       msg.Authentication,
-		AssertionMethod:      msg.AssertionMethod,
-		CapabilityInvocation: msg.CapabilityInvocation,
-		CapabilityDelegation: msg.CapabilityDelegation,
-		KeyAgreement:         msg.KeyAgreement,
+		Authentication:       mergeLegacyVerificationRelationship(msg.LegacyAuthentication, msg.Authentication),
+		AssertionMethod:      mergeLegacyVerificationRelationship(msg.LegacyAssertionMethod, msg.AssertionMethod),
+		CapabilityInvocation: mergeLegacyVerificationRelationship(msg.LegacyCapabilityInvocation, msg.CapabilityInvocation),
+		CapabilityDelegation: mergeLegacyVerificationRelationship(msg.LegacyCapabilityDelegation, msg.CapabilityDelegation),
+		KeyAgreement:         mergeLegacyVerificationRelationship(msg.LegacyKeyAgreement, msg.KeyAgreement),
 		AlsoKnownAs:          msg.AlsoKnownAs,
 		Service:              msg.Service,
+		BoundAccount:         msg.BoundAccount,
 	}
 }
 
@@ -26,7 +26,7 @@ func TestMsgCreateDidValidation(t *testing.T) {
 							PublicKeyMultibase: ValidEd25519PubKey,
 						},
 					},
-					Authentication: []string{"did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#aaa"},
+					Authentication: VerificationMethodReferences("did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#aaa"),
 				},
 				Signatures: nil,
 			},
@@ -45,7 +45,7 @@ func TestMsgCreateDidValidation(t *testing.T) {
 							PublicKeyMultibase: ValidEd25519PubKey,
 						},
 					},
-					Authentication: []string{"did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#key1"},
+					Authentication: VerificationMethodReferences("did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#key1"),
 				},
 				Signatures: nil,
 			},
@@ -62,7 +62,7 @@ func TestMsgCreateDidValidation(t *testing.T) {
 				require.NoError(t, err)
 			} else {
 				require.Error(t, err)
-				require.Equal(t, err.Error(), tc.errorMsg)
+				require.Equal(t, TrimErrorDetails(err.Error()), tc.errorMsg)
 			}
 		})
 	}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgSendResolveDid{}
+
+func NewMsgSendResolveDid(signer, sourcePort, sourceChannel, id string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) *MsgSendResolveDid {
+	return &MsgSendResolveDid{
+		Signer:                signer,
+		SourcePort:            sourcePort,
+		SourceChannel:         sourceChannel,
+		Id:                    id,
+		TimeoutRevisionNumber: timeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: timeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSendResolveDid) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendResolveDid) Type() string {
+	return "MsgSendResolveDid"
+}
+
+func (msg *MsgSendResolveDid) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgSendResolveDid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendResolveDid) ValidateBasic() error {
+	err := msg.Validate()
+	if err != nil {
+		return WithDetails(ErrBasicValidation.Wrap(err.Error()), ValidationErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetTimeoutHeight returns the timeout height on the counterparty chain
+func (msg MsgSendResolveDid) GetTimeoutHeight() clienttypes.Height {
+	return clienttypes.NewHeight(msg.TimeoutRevisionNumber, msg.TimeoutRevisionHeight)
+}
+
+// Validate
+
+func (msg MsgSendResolveDid) Validate() error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Signer, validation.Required, IsAccAddress()),
+		validation.Field(&msg.SourcePort, validation.Required, validation.By(isStringIdentifier(host.PortIdentifierValidator))),
+		validation.Field(&msg.SourceChannel, validation.Required, validation.By(isStringIdentifier(host.ChannelIdentifierValidator))),
+		// Namespaces of the counterparty chain are not known, so only the generic DID format is checked
+		validation.Field(&msg.Id, validation.Required, IsDID(nil)),
+		validation.Field(&msg.TimeoutTimestamp, validation.By(func(value interface{}) error {
+			if msg.GetTimeoutHeight().IsZero() && msg.TimeoutTimestamp == 0 {
+				return errors.New("timeout height and timeout timestamp cannot both be 0")
+			}
+
+			return nil
+		})),
+	)
+}
+
+func isStringIdentifier(validator host.ValidateFn) validation.RuleFunc {
+	return func(value interface{}) error {
+		return validator(value.(string))
+	}
+}
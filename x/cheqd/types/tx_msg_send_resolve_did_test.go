@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSendResolveDidValidation(t *testing.T) {
+	signer := sdk.AccAddress("signer").String()
+	did := "did:cheqd:" + DefaultDidNamespace + ":aaaaaaaaaaaaaaaa"
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+
+	cases := []struct {
+		name   string
+		msg    *MsgSendResolveDid
+		errMsg string
+	}{
+		{
+			name: "Valid: timeout height",
+			msg:  NewMsgSendResolveDid(signer, PortID, "channel-0", did, timeoutHeight, 0),
+		},
+		{
+			name: "Valid: timeout timestamp",
+			msg:  NewMsgSendResolveDid(signer, PortID, "channel-0", did, clienttypes.ZeroHeight(), 1),
+		},
+		{
+			name:   "Not valid: no timeout",
+			msg:    NewMsgSendResolveDid(signer, PortID, "channel-0", did, clienttypes.ZeroHeight(), 0),
+			errMsg: "timeout_timestamp: timeout height and timeout timestamp cannot both be 0",
+		},
+		{
+			name:   "Not valid: invalid channel",
+			msg:    NewMsgSendResolveDid(signer, PortID, "c", did, timeoutHeight, 0),
+			errMsg: "source_channel",
+		},
+		{
+			name:   "Not valid: invalid DID",
+			msg:    NewMsgSendResolveDid(signer, PortID, "channel-0", "aaaaaaaaaaaaaaaa", timeoutHeight, 0),
+			errMsg: "id",
+		},
+		{
+			name:   "Not valid: no signer",
+			msg:    NewMsgSendResolveDid("", PortID, "channel-0", did, timeoutHeight, 0),
+			errMsg: "signer: cannot be blank",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrBasicValidation)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
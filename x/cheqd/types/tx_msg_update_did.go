@@ -22,8 +22,19 @@ func (msg *MsgUpdateDid) Type() string {
 	return "WriteRequest"
 }
 
+// GetSigners returns the account submitting the operation if it's set. Identity operations are authorized
+// by DID signatures, the account makes them executable with authz MsgExec on its behalf.
 func (msg *MsgUpdateDid) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{}
+	if msg.Signer == "" {
+		return []sdk.AccAddress{}
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
 }
 
 func (msg *MsgUpdateDid) GetSignBytes() []byte {
@@ -34,7 +45,7 @@ func (msg *MsgUpdateDid) GetSignBytes() []byte {
 func (msg *MsgUpdateDid) ValidateBasic() error {
 	err := msg.Validate(nil)
 	if err != nil {
-		return ErrBasicValidation.Wrap(err.Error())
+		return WithDetails(ErrBasicValidation.Wrap(err.Error()), ValidationErrorDetails(err))
 	}
 
 	return nil
@@ -46,5 +57,7 @@ func (msg MsgUpdateDid) Validate(allowedNamespaces []string) error {
 	return validation.ValidateStruct(&msg,
 		validation.Field(&msg.Payload, validation.Required, ValidMsgUpdateDidPayloadRule(allowedNamespaces)),
 		validation.Field(&msg.Signatures, IsUniqueSignInfoListRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+		validation.Field(&msg.SignMode, IsValidSignMode()),
+		validation.Field(&msg.Signer, validation.When(msg.Signer != "", IsAccAddress())),
 	)
 }
@@ -26,7 +26,7 @@ func TestMsgUpdateDidValidation(t *testing.T) {
 							PublicKeyMultibase: ValidEd25519PubKey,
 						},
 					},
-					Authentication: []string{"did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#aaa"},
+					Authentication: VerificationMethodReferences("did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#aaa"),
 					VersionId:      "version1",
 				},
 				Signatures: nil,
@@ -46,7 +46,7 @@ func TestMsgUpdateDidValidation(t *testing.T) {
 							PublicKeyMultibase: ValidEd25519PubKey,
 						},
 					},
-					Authentication: []string{"did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#key1"},
+					Authentication: VerificationMethodReferences("did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#key1"),
 					VersionId:      "version1",
 				},
 				Signatures: nil,
@@ -67,7 +67,7 @@ func TestMsgUpdateDidValidation(t *testing.T) {
 							PublicKeyMultibase: ValidEd25519PubKey,
 						},
 					},
-					Authentication: []string{"did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#aaa"},
+					Authentication: VerificationMethodReferences("did:cheqd:testnet:123456789abcdefg#key1", "did:cheqd:testnet:123456789abcdefg#aaa"),
 				},
 				Signatures: nil,
 			},
@@ -84,7 +84,7 @@ func TestMsgUpdateDidValidation(t *testing.T) {
 				require.NoError(t, err)
 			} else {
 				require.Error(t, err)
-				require.Equal(t, err.Error(), tc.errorMsg)
+				require.Equal(t, TrimErrorDetails(err.Error()), tc.errorMsg)
 			}
 		})
 	}
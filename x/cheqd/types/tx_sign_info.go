@@ -24,6 +24,17 @@ func GetSignInfoIds(infos []*SignInfo) []string {
 	return res
 }
 
+// GetSignInfoSigners returns unique DIDs of the signers referenced by the sign infos
+func GetSignInfoSigners(infos []*SignInfo) []string {
+	res := make([]string, len(infos))
+
+	for i := range infos {
+		res[i], _, _, _ = utils.MustSplitDIDUrl(infos[i].VerificationMethodId)
+	}
+
+	return utils.UniqueSorted(res)
+}
+
 func IsUniqueSignInfoList(infos []*SignInfo) bool {
 	tmp_ := map[SignInfo]bool{}
 	for _, si := range infos {
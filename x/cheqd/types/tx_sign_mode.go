@@ -0,0 +1,83 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// GetCanonicalJSONSignBytes returns the canonical JSON form (JCS, RFC 8785) of the payload.
+// Fields are named as in proto files, fields with default values are omitted.
+func GetCanonicalJSONSignBytes(payload proto.Message) ([]byte, error) {
+	marshaler := jsonpb.Marshaler{OrigName: true}
+
+	payloadJson, err := marshaler.MarshalToString(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// jsonpb omits nil lists but emits empty ones, while they are the same after decoding
+	decoder := json.NewDecoder(bytes.NewReader([]byte(payloadJson)))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	normalized, err := json.Marshal(removeEmptyLists(value))
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.CanonicalizeJSON(normalized)
+}
+
+func removeEmptyLists(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if list, ok := item.([]interface{}); ok && len(list) == 0 {
+				delete(value, key)
+				continue
+			}
+
+			value[key] = removeEmptyLists(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = removeEmptyLists(item)
+		}
+	}
+
+	return value
+}
+
+func getSignBytesForMode(msg IdentityMsg, mode SignMode) ([]byte, error) {
+	switch mode {
+	case SignMode_SIGN_MODE_BINARY:
+		return msg.GetSignBytes(), nil
+	case SignMode_SIGN_MODE_JCS:
+		return GetCanonicalJSONSignBytes(msg)
+	default:
+		return nil, fmt.Errorf("unsupported sign mode: %s", mode)
+	}
+}
+
+// Validation
+
+func IsValidSignMode() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(SignMode)
+		if !ok {
+			panic("IsValidSignMode must be only applied on sign mode properties")
+		}
+
+		return validation.Validate(int32(casted), validation.In(int32(SignMode_SIGN_MODE_BINARY), int32(SignMode_SIGN_MODE_JCS)))
+	})
+}
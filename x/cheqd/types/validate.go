@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/multiformats/go-multibase"
 )
 
@@ -101,6 +102,29 @@ func IsURI() *CustomErrorRule {
 	})
 }
 
+func IsAbsoluteURL() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsAbsoluteURL must be only applied on string properties")
+		}
+
+		return utils.ValidateAbsoluteURL(casted)
+	})
+}
+
+func IsAccAddress() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsAccAddress must be only applied on string properties")
+		}
+
+		_, err := sdk.AccAddressFromBech32(casted)
+		return err
+	})
+}
+
 func IsMultibase() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(string)
@@ -133,6 +157,38 @@ func IsMultibaseEncodedEd25519PubKey() *CustomErrorRule {
 	})
 }
 
+func IsMultibaseEncodedSecp256k1PubKey() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsMultibaseEncodedSecp256k1PubKey must be only applied on string properties")
+		}
+
+		_, keyBytes, err := multibase.Decode(casted)
+		if err != nil {
+			return err
+		}
+
+		return utils.ValidateSecp256k1PubKey(keyBytes)
+	})
+}
+
+func IsMultibaseEncodedX25519PubKey() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsMultibaseEncodedX25519PubKey must be only applied on string properties")
+		}
+
+		_, keyBytes, err := multibase.Decode(casted)
+		if err != nil {
+			return err
+		}
+
+		return utils.ValidateX25519PubKey(keyBytes)
+	})
+}
+
 func IsJWK() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.([]*KeyValuePair)
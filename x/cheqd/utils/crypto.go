@@ -10,8 +10,12 @@ import (
 	"reflect"
 
 	"filippo.io/edwards25519"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"golang.org/x/crypto/curve25519"
 
 	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/x25519"
 )
 
 func ValidateJWK(jwk_string string) error {
@@ -31,8 +35,13 @@ func ValidateJWK(jwk_string string) error {
 		if err != nil {
 			return err
 		}
+	case x25519.PublicKey:
+		err := ValidateX25519PubKey(key)
+		if err != nil {
+			return err
+		}
 	default:
-		return fmt.Errorf("unsupported jwk type: %s. supported types are: rsa/pub, ecdsa/pub, ed25519/pub", reflect.TypeOf(raw).Name())
+		return fmt.Errorf("unsupported jwk type: %s. supported types are: rsa/pub, ecdsa/pub, ed25519/pub, x25519/pub", reflect.TypeOf(raw).Name())
 	}
 
 	return nil
@@ -49,6 +58,38 @@ func ValidateEd25519PubKey(keyBytes []byte) error {
 	return nil
 }
 
+// ValidateSecp256k1PubKey checks that the key is a compressed point of the secp256k1 curve
+func ValidateSecp256k1PubKey(keyBytes []byte) error {
+	if l := len(keyBytes); l != secp256k1.PubKeySize {
+		return fmt.Errorf("secp256k1: bad public key length: %d", l)
+	}
+
+	_, err := btcec.ParsePubKey(keyBytes, btcec.S256())
+	if err != nil {
+		return fmt.Errorf("secp256k1: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateX25519PubKey checks the length of the key and rejects low-order points,
+// which would produce an all-zero shared secret
+func ValidateX25519PubKey(keyBytes []byte) error {
+	if l := len(keyBytes); l != curve25519.PointSize {
+		return fmt.Errorf("x25519: bad public key length: %d", l)
+	}
+
+	_, err := curve25519.X25519(lowOrderCheckScalar[:], keyBytes)
+	if err != nil {
+		return fmt.Errorf("x25519: %s", err.Error())
+	}
+
+	return nil
+}
+
+// Any scalar detects low-order points, as it is clamped to a multiple of the cofactor
+var lowOrderCheckScalar = [curve25519.ScalarSize]byte{1}
+
 func VerifyED25519Signature(pubKey ed25519.PublicKey, message []byte, signature []byte) error {
 	valid := ed25519.Verify(pubKey, message, signature)
 	if !valid {
@@ -72,6 +113,16 @@ func VerifyRSASignature(pubKey rsa.PublicKey, message []byte, signature []byte)
 	return nil
 }
 
+// VerifySecp256k1Signature uses SHA256 digest and R || S encoding in lower-S form, the same as account keys do
+func VerifySecp256k1Signature(pubKey []byte, message []byte, signature []byte) error {
+	key := secp256k1.PubKey{Key: pubKey}
+
+	if !key.VerifySignature(message, signature) {
+		return errors.New("invalid secp256k1 signature")
+	}
+	return nil
+}
+
 // VerifyECDSASignature uses ASN1 to decode r and s, SHA265 to calculate message digest
 func VerifyECDSASignature(pubKey ecdsa.PublicKey, message []byte, signature []byte) error {
 	hasher := crypto.SHA256.New()
@@ -1,33 +1,53 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"math/big"
 	"regexp"
 	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils/didurl"
 )
 
+const Base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
 var (
-	SplitDIDRegexp, _     = regexp.Compile(`^did:([^:]+?)(:([^:]+?))?:([^:]+)$`)
 	DidNamespaceRegexp, _ = regexp.Compile(`^[a-zA-Z0-9]*$`)
+	UUIDRegexp, _         = regexp.Compile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
 )
 
+// SelfCertifyingUniqueIdLength is the length of unique ids derived from public keys
+const SelfCertifyingUniqueIdLength = 32
+
 // TrySplitDID Validates generic format of DID. It doesn't validate method, name and id content.
+// Like DID URLs, DIDs must be in the normalized form.
 // Call ValidateDID for further validation.
 func TrySplitDID(did string) (method string, namespace string, id string, err error) {
 	// Example: did:cheqd:testnet:base58str1ng1111
-	// match [0] - the whole string
-	// match [1] - cheqd                - method
-	// match [2] - :testnet
-	// match [3] - testnet              - namespace
-	// match [4] - base58str1ng1111     - id
-	matches := SplitDIDRegexp.FindAllStringSubmatch(did, -1)
-	if len(matches) != 1 {
-		return "", "", "", errors.New("unable to split did into method, namespace and id")
-	}
-
-	match := matches[0]
-	return match[1], match[3], match[4], nil
+	// method - cheqd
+	// method-specific id - testnet:base58str1ng1111, split into namespace and id
+	parsed, err := didurl.ParseDID(did)
+	if err != nil {
+		return "", "", "", fmt.Errorf("unable to split did into method, namespace and id: %w", err)
+	}
+
+	if normalized := parsed.Normalize(); normalized != *parsed {
+		return "", "", "", fmt.Errorf("did must be normalized: %s", normalized.String())
+	}
+
+	parts := strings.Split(parsed.MethodSpecificId, ":")
+	switch {
+	case len(parts) == 1:
+		return parsed.Method, "", parts[0], nil
+	case len(parts) == 2 && parts[0] != "":
+		return parsed.Method, parts[0], parts[1], nil
+	default:
+		return "", "", "", errors.New("unable to split did into method, namespace and id: method-specific id must be [namespace:]id")
+	}
 }
 
 func MustSplitDID(did string) (method string, namespace string, id string) {
@@ -78,7 +98,17 @@ func ValidateDID(did string, method string, allowedNamespaces []string) error {
 	return err
 }
 
+// ValidateUniqueId accepts unique ids of all supported formats: base58 strings of 16 or 32 symbols and UUIDs.
+// Formats allowed for new DIDs are checked separately.
 func ValidateUniqueId(uniqueId string) error {
+	if IsUUID(uniqueId) {
+		return nil
+	}
+
+	return ValidateBase58UniqueId(uniqueId)
+}
+
+func ValidateBase58UniqueId(uniqueId string) error {
 	// Length should be 16 or 32 symbols
 	if len(uniqueId) != 16 && len(uniqueId) != 32 {
 		return fmt.Errorf("unique id length should be 16 or 32 symbols")
@@ -92,6 +122,52 @@ func ValidateUniqueId(uniqueId string) error {
 	return nil
 }
 
+// GenerateUniqueId generates a random base58 unique id of the given length (16 or 32 symbols)
+func GenerateUniqueId(length int) (string, error) {
+	if length != 16 && length != 32 {
+		return "", fmt.Errorf("unique id length should be 16 or 32 symbols")
+	}
+
+	alphabetSize := big.NewInt(int64(len(Base58Alphabet)))
+	res := make([]byte, length)
+
+	for i := range res {
+		index, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+
+		res[i] = Base58Alphabet[index.Int64()]
+	}
+
+	return string(res), nil
+}
+
+// IsUUID checks that the unique id is a UUID in lowercase hex with dashes
+func IsUUID(uniqueId string) bool {
+	return UUIDRegexp.MatchString(uniqueId)
+}
+
+// GenerateUUID generates a random (version 4) UUID
+func GenerateUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// SelfCertifyingUniqueId derives a base58 unique id from the public key:
+// the first 32 symbols of base58 encoded SHA-256 hash of the key.
+func SelfCertifyingUniqueId(publicKey []byte) string {
+	hash := sha256.Sum256(publicKey)
+	return base58.Encode(hash[:])[:SelfCertifyingUniqueIdLength]
+}
+
 func IsValidDID(did string, method string, allowedNamespaces []string) bool {
 	err := ValidateDID(did, method, allowedNamespaces)
 	return err == nil
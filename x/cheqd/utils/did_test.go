@@ -20,18 +20,20 @@ func TestIsDid(t *testing.T) {
 		{"Valid: Inputs: Method and namespaces are empty", true, "did:cheqd:testnet:123456789abcdefg", "", []string{}},
 		{"Valid: Namespace is absent in DID", true, "did:cheqd:123456789abcdefg", "", []string{}},
 		// Generic method validation
-		{"Valid: Inputs: Method is not set and passed for NOTcheqd", true, "did:NOTcheqd:123456789abcdefg", "", []string{}},
-		{"Valid: Inputs: Method and Namespaces are not set and passed for NOTcheqd", true, "did:NOTcheqd:123456789abcdefg123456789abcdefg", "", []string{}},
+		{"Valid: Inputs: Method is not set and passed for notcheqd", true, "did:notcheqd:123456789abcdefg", "", []string{}},
+		{"Valid: Inputs: Method and Namespaces are not set and passed for notcheqd", true, "did:notcheqd:123456789abcdefg123456789abcdefg", "", []string{}},
 
 		{"Valid: Inputs: Order of namespaces changed", true, "did:cheqd:testnet:123456789abcdefg", "cheqd", []string{"mainnet", "testnet"}},
-		// Wrong splitting (^did:([^:]+?)(:([^:]+?))?:([^:]+)$)
+		// Wrong splitting (did:method:[namespace:]id)
 		{"Not valid: DID is not started from 'did'", false, "did123:cheqd:::123456789abcdefg", "cheqd", []string{"testnet"}},
 		{"Not valid: empty namespace", false, "did:cheqd::123456789abcdefg", "cheqd", []string{"testnet"}},
 		{"Not valid: a lot of ':'", false, "did:cheqd:::123456789abcdefg", "cheqd", []string{"testnet"}},
 		{"Not valid: several DIDs in one string", false, "did:cheqd:testnet:123456789abcdefg:did:cheqd:testnet:123456789abcdefg", "cheqd", []string{"testnet"}},
 		// Wrong method
-		{"Not valid: method in DID is not the same as from Inputs", false, "did:NOTcheqd:testnet:123456789abcdefg", "cheqd", []string{"mainnet", "testnet"}},
-		{"Not valid: method in Inputs is not the same as from DID", false, "did:cheqd:testnet:123456789abcdefg", "NOTcheqd", []string{"mainnet", "testnet"}},
+		{"Not valid: method in DID is not the same as from Inputs", false, "did:notcheqd:testnet:123456789abcdefg", "cheqd", []string{"mainnet", "testnet"}},
+		{"Not valid: method in Inputs is not the same as from DID", false, "did:cheqd:testnet:123456789abcdefg", "notcheqd", []string{"mainnet", "testnet"}},
+		{"Not valid: method must be lowercase", false, "did:NOTcheqd:123456789abcdefg", "", []string{}},
+		{"Not valid: empty method", false, "did::123456789abcdefg", "", []string{}},
 		// Wrong namespace (^[a-zA-Z0-9]*)
 		{"Not valid: / is not allowed for namespace", false, "did:cheqd:testnet/:123456789abcdefg", "cheqd", []string{}},
 		{"Not valid: _ is not allowed for namespace", false, "did:cheqd:testnet_:123456789abcdefg", "cheqd", []string{}},
@@ -71,8 +73,8 @@ func TestSplitJoin(t *testing.T) {
 		"did:cheqd:testnet:123456789abcdefg",
 		"did:cheqd:testnet:123456789abcdefg",
 		"did:cheqd:123456789abcdefg",
-		"did:NOTcheqd:123456789abcdefg",
-		"did:NOTcheqd:123456789abcdefg123456789abcdefg",
+		"did:notcheqd:123456789abcdefg",
+		"did:notcheqd:123456789abcdefg123456789abcdefg",
 		"did:cheqd:testnet:123456789abcdefg",
 	}
 
@@ -95,3 +97,68 @@ func TestMustSplitDID(t *testing.T) {
 	require.Equal(t, "mainnet", namespace)
 	require.Equal(t, "qqqqqqqqqqqqqqqq", id)
 }
+
+func TestTrySplitDIDRejectsNotNormalized(t *testing.T) {
+	_, _, _, err := TrySplitDID("did:example:abc%2d")
+	require.EqualError(t, err, "did must be normalized: did:example:abc-")
+
+	_, _, _, err = TrySplitDID("did:example:abc%2f")
+	require.EqualError(t, err, "did must be normalized: did:example:abc%2F")
+
+	_, _, _, _, err = TrySplitDIDUrl("did:example:abc#key%7e1")
+	require.EqualError(t, err, "did url must be normalized: did:example:abc#key~1")
+}
+
+func TestGenerateUniqueId(t *testing.T) {
+	for _, length := range []int{16, 32} {
+		id, err := GenerateUniqueId(length)
+		require.NoError(t, err)
+		require.Len(t, id, length)
+		require.NoError(t, ValidateUniqueId(id))
+	}
+
+	_, err := GenerateUniqueId(20)
+	require.Error(t, err)
+}
+
+func TestValidateUniqueIdFormats(t *testing.T) {
+	cases := []struct {
+		name     string
+		uniqueId string
+		valid    bool
+	}{
+		{"Valid: base58 of 16 symbols", "123456789abcdefg", true},
+		{"Valid: base58 of 32 symbols", "123456789abcdefg123456789abcdefg", true},
+		{"Valid: UUID", "3b9b8eec-5b5d-4382-86d8-9185126ff130", true},
+		{"Not valid: UUID in upper case", "3B9B8EEC-5B5D-4382-86D8-9185126FF130", false},
+		{"Not valid: UUID without dashes", "3b9b8eec5b5d438286d89185126ff130", false},
+		{"Not valid: base58 of 20 symbols", "123456789abcdefg1234", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateUniqueId(tc.uniqueId)
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateUUID(t *testing.T) {
+	id, err := GenerateUUID()
+	require.NoError(t, err)
+	require.True(t, IsUUID(id))
+	require.Equal(t, byte('4'), id[14])
+}
+
+func TestSelfCertifyingUniqueId(t *testing.T) {
+	id := SelfCertifyingUniqueId([]byte("public key"))
+	require.Len(t, id, SelfCertifyingUniqueIdLength)
+	require.NoError(t, ValidateUniqueId(id))
+	require.Equal(t, id, SelfCertifyingUniqueId([]byte("public key")))
+	require.NotEqual(t, id, SelfCertifyingUniqueId([]byte("another public key")))
+}
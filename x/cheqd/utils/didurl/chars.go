@@ -0,0 +1,158 @@
+package didurl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Character classes from RFC 3986: https://www.rfc-editor.org/rfc/rfc3986#section-2
+
+const upperHex = "0123456789ABCDEF"
+
+func isAlpha(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// unreserved = ALPHA / DIGIT / "-" / "." / "_" / "~"
+func isUnreserved(c byte) bool {
+	return isAlpha(c) || isDigit(c) || c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// sub-delims = "!" / "$" / "&" / "'" / "(" / ")" / "*" / "+" / "," / ";" / "="
+func isSubDelim(c byte) bool {
+	return strings.IndexByte("!$&'()*+,;=", c) >= 0
+}
+
+// pchar = unreserved / pct-encoded / sub-delims / ":" / "@"
+func isPChar(c byte) bool {
+	return isUnreserved(c) || isSubDelim(c) || c == ':' || c == '@'
+}
+
+// path = *( pchar / "/" )
+func isPathChar(c byte) bool {
+	return isPChar(c) || c == '/'
+}
+
+// query = fragment = *( pchar / "/" / "?" )
+func isQueryChar(c byte) bool {
+	return isPChar(c) || c == '/' || c == '?'
+}
+
+// userinfo = *( unreserved / pct-encoded / sub-delims / ":" )
+func isUserInfoChar(c byte) bool {
+	return isUnreserved(c) || isSubDelim(c) || c == ':'
+}
+
+// reg-name = *( unreserved / pct-encoded / sub-delims )
+func isRegNameChar(c byte) bool {
+	return isUnreserved(c) || isSubDelim(c)
+}
+
+// idchar = ALPHA / DIGIT / "." / "-" / "_" / pct-encoded
+func isIdChar(c byte) bool {
+	return isAlpha(c) || isDigit(c) || c == '.' || c == '-' || c == '_'
+}
+
+// validateChars checks that the string consists of allowed characters and valid percent-encoded octets
+func validateChars(part string, value string, allowed func(byte) bool) error {
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		if c == '%' {
+			if i+2 >= len(value) || !isHexDigit(value[i+1]) || !isHexDigit(value[i+2]) {
+				return fmt.Errorf("%s: invalid percent-encoding at position %d", part, i)
+			}
+
+			i += 2
+			continue
+		}
+
+		if !allowed(c) {
+			return fmt.Errorf("%s: invalid character %q at position %d", part, c, i)
+		}
+	}
+
+	return nil
+}
+
+// NormalizePercentEncoding decodes percent-encoded unreserved characters and uppercases hex digits
+// of other percent-encoded octets, see https://www.rfc-editor.org/rfc/rfc3986#section-6.2.2
+func NormalizePercentEncoding(value string) string {
+	return normalizePercentEncoding(value, isUnreserved)
+}
+
+// normalizePercentEncoding decodes percent-encoded octets that are allowed to appear decoded
+func normalizePercentEncoding(value string, decode func(byte) bool) string {
+	if strings.IndexByte(value, '%') < 0 {
+		return value
+	}
+
+	var res strings.Builder
+	res.Grow(len(value))
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		if c != '%' || i+2 >= len(value) || !isHexDigit(value[i+1]) || !isHexDigit(value[i+2]) {
+			res.WriteByte(c)
+			continue
+		}
+
+		decoded := unhex(value[i+1])<<4 | unhex(value[i+2])
+		if decode(decoded) {
+			res.WriteByte(decoded)
+		} else {
+			res.WriteByte('%')
+			res.WriteByte(upperHex[decoded>>4])
+			res.WriteByte(upperHex[decoded&0x0f])
+		}
+
+		i += 2
+	}
+
+	return res.String()
+}
+
+func unhex(c byte) byte {
+	switch {
+	case isDigit(c):
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
+// cut splits the string at the first occurrence of the separator. The separator is kept in neither part.
+func cut(value string, sep byte) (before string, after string, found bool) {
+	if i := strings.IndexByte(value, sep); i >= 0 {
+		return value[:i], value[i+1:], true
+	}
+
+	return value, "", false
+}
+
+// splitQueryAndFragment splits off the fragment and the query, which are the same for URIs and DID URLs
+func splitQueryAndFragment(value string) (rest string, query string, fragment string, err error) {
+	rest, fragment, _ = cut(value, '#')
+	rest, query, _ = cut(rest, '?')
+
+	if err := validateChars("query", query, isQueryChar); err != nil {
+		return "", "", "", err
+	}
+
+	if err := validateChars("fragment", fragment, isQueryChar); err != nil {
+		return "", "", "", err
+	}
+
+	return rest, query, fragment, nil
+}
@@ -0,0 +1,132 @@
+package didurl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const didScheme = "did:"
+
+// DID is a decentralized identifier as defined in https://www.w3.org/TR/did-core/#did-syntax:
+// "did:" method-name ":" method-specific-id
+type DID struct {
+	Method           string
+	MethodSpecificId string
+}
+
+// DIDURL is a DID URL as defined in https://www.w3.org/TR/did-core/#did-url-syntax:
+// did path-abempty [ "?" query ] [ "#" fragment ]
+type DIDURL struct {
+	DID
+	Path     string
+	Query    string
+	Fragment string
+}
+
+// ParseDID parses and validates a DID. DID URLs are rejected.
+func ParseDID(value string) (*DID, error) {
+	if !strings.HasPrefix(value, didScheme) {
+		return nil, fmt.Errorf("did must start with %q", didScheme)
+	}
+
+	method, methodSpecificId, found := cut(value[len(didScheme):], ':')
+	if !found {
+		return nil, errors.New("did must have a method and a method-specific id")
+	}
+
+	if err := validateMethod(method); err != nil {
+		return nil, err
+	}
+
+	if err := validateMethodSpecificId(methodSpecificId); err != nil {
+		return nil, err
+	}
+
+	return &DID{
+		Method:           method,
+		MethodSpecificId: methodSpecificId,
+	}, nil
+}
+
+// ParseDIDURL parses and validates a DID URL. A DID is a valid DID URL with empty path, query and fragment.
+func ParseDIDURL(value string) (*DIDURL, error) {
+	rest, query, fragment, err := splitQueryAndFragment(value)
+	if err != nil {
+		return nil, err
+	}
+
+	did, path := rest, ""
+	if i := strings.IndexByte(rest, '/'); i >= 0 {
+		did, path = rest[:i], rest[i:]
+	}
+
+	parsedDid, err := ParseDID(did)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateChars("path", path, isPathChar); err != nil {
+		return nil, err
+	}
+
+	return &DIDURL{
+		DID:      *parsedDid,
+		Path:     path,
+		Query:    query,
+		Fragment: fragment,
+	}, nil
+}
+
+// method-name = 1*method-char
+// method-char = %x61-7A / DIGIT
+func validateMethod(method string) error {
+	if method == "" {
+		return errors.New("did method must not be empty")
+	}
+
+	for i := 0; i < len(method); i++ {
+		c := method[i]
+		if !('a' <= c && c <= 'z') && !isDigit(c) {
+			return fmt.Errorf("did method: invalid character %q at position %d", c, i)
+		}
+	}
+
+	return nil
+}
+
+// method-specific-id = *( *idchar ":" ) 1*idchar
+func validateMethodSpecificId(id string) error {
+	if id == "" || strings.HasSuffix(id, ":") {
+		return errors.New("did method-specific id must not be empty or end with ':'")
+	}
+
+	return validateChars("did method-specific id", id, func(c byte) bool {
+		return isIdChar(c) || c == ':'
+	})
+}
+
+func (d DID) String() string {
+	return didScheme + d.Method + ":" + d.MethodSpecificId
+}
+
+// Normalize applies percent-encoding normalization. DIDs are case-sensitive otherwise.
+// Only characters allowed in method-specific ids are decoded, e.g. ~ stays percent-encoded.
+func (d DID) Normalize() DID {
+	d.MethodSpecificId = normalizePercentEncoding(d.MethodSpecificId, isIdChar)
+	return d
+}
+
+func (u DIDURL) String() string {
+	return u.DID.String() + joinPathQueryAndFragment(u.Path, u.Query, u.Fragment)
+}
+
+// Normalize applies percent-encoding normalization to all components of the DID URL
+func (u DIDURL) Normalize() DIDURL {
+	u.DID = u.DID.Normalize()
+	u.Path = NormalizePercentEncoding(u.Path)
+	u.Query = NormalizePercentEncoding(u.Query)
+	u.Fragment = NormalizePercentEncoding(u.Fragment)
+
+	return u
+}
@@ -0,0 +1,62 @@
+package didurl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDIDURL(t *testing.T) {
+	cases := []struct {
+		name     string
+		valid    bool
+		didUrl   string
+		expected DIDURL
+	}{
+		{"Valid: DID", true, "did:cheqd:testnet:123456789abcdefg", DIDURL{DID: DID{"cheqd", "testnet:123456789abcdefg"}}},
+		{"Valid: fragment", true, "did:cheqd:testnet:123456789abcdefg#key-1", DIDURL{DID: DID{"cheqd", "testnet:123456789abcdefg"}, Fragment: "key-1"}},
+		{"Valid: path, query and fragment", true, "did:example:123/path/to?service=agent&relativeRef=/x?y#frag/ment?", DIDURL{DID: DID{"example", "123"}, Path: "/path/to", Query: "service=agent&relativeRef=/x?y", Fragment: "frag/ment?"}},
+		{"Valid: percent-encoded method-specific id", true, "did:example:a%3Ab:c", DIDURL{DID: DID{"example", "a%3Ab:c"}}},
+		{"Valid: empty path", true, "did:example:123/", DIDURL{DID: DID{"example", "123"}, Path: "/"}},
+		{"Not valid: not a DID", false, "https://example.com", DIDURL{}},
+		{"Not valid: no method-specific id", false, "did:example", DIDURL{}},
+		{"Not valid: method-specific id ends with ':'", false, "did:example:123:", DIDURL{}},
+		{"Not valid: uppercase method", false, "did:Example:123", DIDURL{}},
+		{"Not valid: '@' in method-specific id", false, "did:example:12@3", DIDURL{}},
+		{"Not valid: '#' in fragment", false, "did:example:123#a#b", DIDURL{}},
+		{"Not valid: '[' in query", false, "did:example:123?a[]=1", DIDURL{}},
+		{"Not valid: broken percent-encoding", false, "did:example:123/%4", DIDURL{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := ParseDIDURL(tc.didUrl)
+
+			if tc.valid {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, *parsed)
+				require.Equal(t, tc.didUrl, parsed.String())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParseDID(t *testing.T) {
+	did, err := ParseDID("did:cheqd:mainnet:123456789abcdefg")
+	require.NoError(t, err)
+	require.Equal(t, DID{Method: "cheqd", MethodSpecificId: "mainnet:123456789abcdefg"}, *did)
+
+	_, err = ParseDID("did:cheqd:mainnet:123456789abcdefg#key-1")
+	require.Error(t, err)
+}
+
+func TestNormalizeDIDURL(t *testing.T) {
+	parsed, err := ParseDIDURL("did:example:%61bc%3a%7e/p%2fa%7eth?q%3d#%4b")
+	require.NoError(t, err)
+
+	// Unreserved characters are decoded, hex digits of the rest are uppercased.
+	// '~' isn't allowed in method-specific ids and stays encoded there.
+	require.Equal(t, "did:example:abc%3A%7E/p%2Fa~th?q%3D#K", parsed.Normalize().String())
+}
@@ -0,0 +1,234 @@
+package didurl
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// URI is an absolute URI as defined in https://www.rfc-editor.org/rfc/rfc3986#section-3:
+// scheme ":" hier-part [ "?" query ] [ "#" fragment ]
+type URI struct {
+	Scheme string
+	// HasAuthority distinguishes an empty authority (file:///path) from a missing one (file:/path)
+	HasAuthority bool
+	UserInfo     string
+	Host         string
+	Port         string
+	Path         string
+	Query        string
+	Fragment     string
+}
+
+// ParseURI parses and validates an absolute URI. Relative references are rejected.
+func ParseURI(value string) (*URI, error) {
+	rest, query, fragment, err := splitQueryAndFragment(value)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, rest, found := cut(rest, ':')
+	if !found {
+		return nil, errors.New("scheme is required")
+	}
+
+	if err := validateScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	uri := URI{
+		Scheme:   scheme,
+		Query:    query,
+		Fragment: fragment,
+	}
+
+	if strings.HasPrefix(rest, "//") {
+		authority := rest[2:]
+
+		if i := strings.IndexByte(authority, '/'); i >= 0 {
+			authority, uri.Path = authority[:i], authority[i:]
+		}
+
+		uri.HasAuthority = true
+		uri.UserInfo, uri.Host, uri.Port, err = parseAuthority(authority)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		uri.Path = rest
+	}
+
+	if err := validateChars("path", uri.Path, isPathChar); err != nil {
+		return nil, err
+	}
+
+	return &uri, nil
+}
+
+// scheme = ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+func validateScheme(scheme string) error {
+	if scheme == "" {
+		return errors.New("scheme is required")
+	}
+
+	if !isAlpha(scheme[0]) {
+		return errors.New("scheme must start with a letter")
+	}
+
+	for i := 1; i < len(scheme); i++ {
+		c := scheme[i]
+		if !isAlpha(c) && !isDigit(c) && c != '+' && c != '-' && c != '.' {
+			return fmt.Errorf("scheme: invalid character %q at position %d", c, i)
+		}
+	}
+
+	return nil
+}
+
+// authority = [ userinfo "@" ] host [ ":" port ]
+func parseAuthority(authority string) (userInfo string, host string, port string, err error) {
+	if i := strings.LastIndexByte(authority, '@'); i >= 0 {
+		userInfo, authority = authority[:i], authority[i+1:]
+
+		if err := validateChars("userinfo", userInfo, isUserInfoChar); err != nil {
+			return "", "", "", err
+		}
+	}
+
+	if strings.HasPrefix(authority, "[") {
+		end := strings.IndexByte(authority, ']')
+		if end < 0 {
+			return "", "", "", errors.New("host: IP literal must be closed with ]")
+		}
+
+		host, authority = authority[:end+1], authority[end+1:]
+		if err := validateIPLiteral(host[1:end]); err != nil {
+			return "", "", "", err
+		}
+
+		if authority != "" && authority[0] != ':' {
+			return "", "", "", errors.New("host: IP literal must be followed by a port or nothing")
+		}
+	} else {
+		end := strings.IndexByte(authority, ':')
+		if end < 0 {
+			end = len(authority)
+		}
+
+		host, authority = authority[:end], authority[end:]
+		if err := validateChars("host", host, isRegNameChar); err != nil {
+			return "", "", "", err
+		}
+	}
+
+	port = strings.TrimPrefix(authority, ":")
+	for i := 0; i < len(port); i++ {
+		if !isDigit(port[i]) {
+			return "", "", "", fmt.Errorf("port: invalid character %q at position %d", port[i], i)
+		}
+	}
+
+	return userInfo, host, port, nil
+}
+
+// IP-literal = "[" ( IPv6address / IPvFuture  ) "]"
+// IPvFuture  = "v" 1*HEXDIG "." 1*( unreserved / sub-delims / ":" )
+func validateIPLiteral(literal string) error {
+	if strings.HasPrefix(literal, "v") || strings.HasPrefix(literal, "V") {
+		version, address, found := cut(literal[1:], '.')
+		if !found || version == "" || address == "" {
+			return errors.New("host: invalid IPvFuture")
+		}
+
+		for i := 0; i < len(version); i++ {
+			if !isHexDigit(version[i]) {
+				return errors.New("host: invalid IPvFuture version")
+			}
+		}
+
+		for i := 0; i < len(address); i++ {
+			if !isUserInfoChar(address[i]) {
+				return errors.New("host: invalid IPvFuture address")
+			}
+		}
+
+		return nil
+	}
+
+	if !strings.Contains(literal, ":") || net.ParseIP(literal) == nil {
+		return errors.New("host: invalid IPv6 address")
+	}
+
+	return nil
+}
+
+// Authority returns the authority component without the leading //
+func (u URI) Authority() string {
+	res := u.Host
+
+	if u.UserInfo != "" {
+		res = u.UserInfo + "@" + res
+	}
+
+	if u.Port != "" {
+		res = res + ":" + u.Port
+	}
+
+	return res
+}
+
+func (u URI) String() string {
+	res := u.Scheme + ":"
+
+	if u.HasAuthority {
+		res = res + "//" + u.Authority()
+	}
+
+	return res + joinPathQueryAndFragment(u.Path, u.Query, u.Fragment)
+}
+
+// Normalize applies case and percent-encoding normalization,
+// see https://www.rfc-editor.org/rfc/rfc3986#section-6.2.2
+func (u URI) Normalize() URI {
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.UserInfo = NormalizePercentEncoding(u.UserInfo)
+	u.Host = lowerOutsidePercentEncoding(NormalizePercentEncoding(u.Host))
+	u.Path = NormalizePercentEncoding(u.Path)
+	u.Query = NormalizePercentEncoding(u.Query)
+	u.Fragment = NormalizePercentEncoding(u.Fragment)
+
+	return u
+}
+
+// lowerOutsidePercentEncoding lowercases the string keeping hex digits of percent-encoded octets uppercase
+func lowerOutsidePercentEncoding(value string) string {
+	res := []byte(value)
+
+	for i := 0; i < len(res); i++ {
+		if res[i] == '%' {
+			i += 2
+			continue
+		}
+
+		if 'A' <= res[i] && res[i] <= 'Z' {
+			res[i] += 'a' - 'A'
+		}
+	}
+
+	return string(res)
+}
+
+func joinPathQueryAndFragment(path string, query string, fragment string) string {
+	res := path
+
+	if query != "" {
+		res = res + "?" + query
+	}
+
+	if fragment != "" {
+		res = res + "#" + fragment
+	}
+
+	return res
+}
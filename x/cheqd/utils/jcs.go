@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// CanonicalizeJSON transforms JSON into its canonical form defined by JSON Canonicalization Scheme (RFC 8785)
+func CanonicalizeJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the top-level JSON value")
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCanonicalJSON(buf *bytes.Buffer, value interface{}) error {
+	switch value := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(value))
+	case json.Number:
+		number, err := canonicalJSONNumber(value)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString(number)
+	case string:
+		writeCanonicalJSONString(buf, value)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range value {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeCanonicalJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+
+		// Properties are sorted by UTF-16 code units of their names
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			writeCanonicalJSONString(buf, key)
+			buf.WriteByte(':')
+
+			if err := writeCanonicalJSON(buf, value[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value: %T", value)
+	}
+
+	return nil
+}
+
+// canonicalJSONNumber serializes the number the way ECMAScript does
+func canonicalJSONNumber(number json.Number) (string, error) {
+	f, err := strconv.ParseFloat(number.String(), 64)
+	if err != nil {
+		return "", err
+	}
+
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("number can't be represented in canonical JSON: %s", number)
+	}
+
+	if f == 0 {
+		return "0", nil
+	}
+
+	abs := math.Abs(f)
+	if abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+
+	// Go pads exponent with zeros (1e-07), ECMAScript doesn't (1e-7)
+	res := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exponent := res[:strings.IndexByte(res, 'e')], res[strings.IndexByte(res, 'e')+1:]
+	sign, digits := exponent[:1], strings.TrimLeft(exponent[1:], "0")
+
+	return mantissa + "e" + sign + digits, nil
+}
+
+func writeCanonicalJSONString(buf *bytes.Buffer, value string) {
+	buf.WriteByte('"')
+
+	for _, r := range value {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+
+	buf.WriteByte('"')
+}
+
+func lessUTF16(a, b string) bool {
+	ua, ub := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+
+	return len(ua) < len(ub)
+}
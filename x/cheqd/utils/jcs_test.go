@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+		isValid  bool
+	}{
+		{"Valid: whitespaces are removed", "{ \"b\" : [ 1 , 2 ] ,\n \"a\" : null }", `{"a":null,"b":[1,2]}`, true},
+		{"Valid: nested objects are sorted", `{"b":{"d":true,"c":false},"a":"x"}`, `{"a":"x","b":{"c":false,"d":true}}`, true},
+		{"Valid: keys are sorted by UTF-16 code units", `{"דּ":1,"😀":2,"a":3}`, "{\"a\":3,\"\U0001F600\":2,\"דּ\":1}", true},
+		{"Valid: strings are escaped minimally", `{"a":"<\/é \u001f\t\"\\"}`, "{\"a\":\"</é \\u001f\\t\\\"\\\\\"}", true},
+		{"Valid: numbers", `[1.0,-0,1e21,1e-7,0.000001,123456789012345680000,1.5E+3]`, `[1,0,1e+21,1e-7,0.000001,123456789012345680000,1500]`, true},
+		{"Not valid: trailing data", `{"a":1}{}`, "", false},
+		{"Not valid: malformed", `{"a":`, "", false},
+		{"Not valid: number out of range", `[1e400]`, "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := CanonicalizeJSON([]byte(tc.input))
+
+			if tc.isValid {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, string(res))
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
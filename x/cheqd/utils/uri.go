@@ -2,16 +2,28 @@ package utils
 
 import (
 	"fmt"
-	"regexp"
-)
 
-// Goes from RFC: https://www.rfc-editor.org/rfc/rfc3986#appendix-B
-var ValidURIRegexp, _ = regexp.Compile(`^(([^:\/?#]+):)?(\/\/([^\/?#]*))?([^?#]*)(\?([^#]*))?(#(.*))?`)
+	"github.com/cheqd/cheqd-node/x/cheqd/utils/didurl"
+)
 
+// ValidateURI checks that the value is an absolute URI as defined in RFC 3986: https://www.rfc-editor.org/rfc/rfc3986#section-4.3
 func ValidateURI(uri string) error {
-	// Match with Regexp from RFC
-	if !ValidURIRegexp.MatchString(uri) {
-		return fmt.Errorf("URI: %s does not match regexp: %s", uri, ValidURIRegexp)
+	if _, err := didurl.ParseURI(uri); err != nil {
+		return fmt.Errorf("URI: %s is not valid: %w", uri, err)
+	}
+
+	return nil
+}
+
+// ValidateAbsoluteURL checks that the URL has a scheme and a host, e.g. a JSON-LD context URL
+func ValidateAbsoluteURL(value string) error {
+	parsed, err := didurl.ParseURI(value)
+	if err != nil {
+		return fmt.Errorf("URL: %s is not valid: %w", value, err)
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("URL: %s is not absolute", value)
 	}
 
 	return nil
@@ -15,7 +15,13 @@ func TestValidateURI(t *testing.T) {
 		// Path: all the possible symbols
 		{"Valid: General http URI path", true, "http://a.com/a/b/c/d/?query=123#fragment=another_part"},
 		{"Valid: General https URI path", true, "https://a.com/a/b/c/d/?query=123#fragment=another_part"},
-		{"Valid: only alphabet symbols", true, "SomeAnotherPath"},
+		{"Valid: DID", true, "did:cheqd:testnet:123456789abcdefg"},
+		{"Valid: URN", true, "urn:uuid:3b9b8eec-5b5d-4382-86d8-9185126ff130"},
+		{"Valid: IPv6 host with port", true, "https://[2001:db8::1]:8080/path"},
+		{"Not valid: relative reference", false, "SomeAnotherPath"},
+		{"Not valid: space in path", false, "https://a.com/some path"},
+		{"Not valid: wrong percent-encoding", false, "https://a.com/%zz"},
+		{"Not valid: port is not a number", false, "https://a.com:port/"},
 	}
 
 	for _, tc := range cases {
@@ -30,3 +36,29 @@ func TestValidateURI(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAbsoluteURL(t *testing.T) {
+	cases := []struct {
+		name  string
+		valid bool
+		URL   string
+	}{
+		{"Valid: https URL", true, "https://www.w3.org/ns/did/v1"},
+		{"Valid: URL with fragment", true, "https://w3id.org/security/suites/ed25519-2020/v1#context"},
+		{"Not valid: relative URL", false, "/ns/did/v1"},
+		{"Not valid: no scheme", false, "SomeAnotherPath"},
+		{"Not valid: URN", false, "urn:uuid:123"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err_ := ValidateAbsoluteURL(tc.URL)
+
+			if tc.valid {
+				require.NoError(t, err_)
+			} else {
+				require.Error(t, err_)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+)
+
+// CheqdQuery is the custom query contracts send to the cheqd query plugin. Exactly one field must be set.
+type CheqdQuery struct {
+	ResolveDid      *ResolveDid      `json:"resolve_did,omitempty"`
+	VerifySignature *VerifySignature `json:"verify_signature,omitempty"`
+}
+
+// ResolveDid resolves a did:cheqd DID Doc stored on the ledger
+type ResolveDid struct {
+	Id string `json:"id"`
+}
+
+// ResolveDidResponse contains the DID Doc and its metadata in the proto JSON format
+type ResolveDidResponse struct {
+	Did      json.RawMessage `json:"did"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// VerifySignature verifies a signature against a verification method of a DID Doc stored on the ledger.
+// Message and signature are base64 encoded, like cosmwasm Binary.
+type VerifySignature struct {
+	VerificationMethodId string `json:"verification_method_id"`
+	Message              []byte `json:"message"`
+	Signature            []byte `json:"signature"`
+}
+
+type VerifySignatureResponse struct {
+	Valid bool `json:"valid"`
+}
+
+// CheqdMsg is the custom message contracts send to submit DID operations. Exactly one field must be set,
+// it's MsgCreateDid or MsgUpdateDid in the proto JSON format. The operations must be signed by the keys
+// of the DIDs, as if they were sent in a transaction.
+type CheqdMsg struct {
+	CreateDid json.RawMessage `json:"create_did,omitempty"`
+	UpdateDid json.RawMessage `json:"update_did,omitempty"`
+}
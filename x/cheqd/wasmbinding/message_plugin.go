@@ -0,0 +1,71 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CustomEncoder returns the encoder of CheqdMsg, it matches the custom encoder of wasmd MessageEncoders.
+// Contracts relay DID operations signed by DID keys. The contract is set as the account signer of the message,
+// messages signed for another account are rejected.
+func CustomEncoder(cdc codec.JSONCodec) func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		var cheqdMsg CheqdMsg
+		if err := json.Unmarshal(msg, &cheqdMsg); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		var result sdk.Msg
+
+		switch {
+		case len(cheqdMsg.CreateDid) > 0 && len(cheqdMsg.UpdateDid) == 0:
+			createDid := &types.MsgCreateDid{}
+			if err := cdc.UnmarshalJSON(cheqdMsg.CreateDid, createDid); err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+
+			signer, err := contractSigner(sender, createDid.Signer)
+			if err != nil {
+				return nil, err
+			}
+
+			createDid.Signer = signer
+			result = createDid
+		case len(cheqdMsg.UpdateDid) > 0 && len(cheqdMsg.CreateDid) == 0:
+			updateDid := &types.MsgUpdateDid{}
+			if err := cdc.UnmarshalJSON(cheqdMsg.UpdateDid, updateDid); err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+
+			signer, err := contractSigner(sender, updateDid.Signer)
+			if err != nil {
+				return nil, err
+			}
+
+			updateDid.Signer = signer
+			result = updateDid
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "exactly one cheqd message variant must be set")
+		}
+
+		if err := result.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		return []sdk.Msg{result}, nil
+	}
+}
+
+// contractSigner returns the address of the contract that sends the message. The signer set in the message,
+// if any, must be the contract itself.
+func contractSigner(sender sdk.AccAddress, signer string) (string, error) {
+	if signer != "" && signer != sender.String() {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the contract %s", signer, sender.String())
+	}
+
+	return sender.String(), nil
+}
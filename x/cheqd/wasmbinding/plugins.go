@@ -0,0 +1,19 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCustomPlugins returns the x/wasm keeper options that plug the cheqd query and message bindings in
+func RegisterCustomPlugins(k keeper.Keeper, cdc codec.JSONCodec) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: CustomQuerier(k),
+		}),
+		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+			Custom: CustomEncoder(cdc),
+		}),
+	}
+}
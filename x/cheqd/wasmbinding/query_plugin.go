@@ -0,0 +1,89 @@
+package wasmbinding
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// VerifySignatureGasCostPerByte is charged per byte of the message of verify_signature queries. Transactions pay
+// for the size of signed payloads with the auth tx size cost, contracts pay for the hashed message with this cost.
+const VerifySignatureGasCostPerByte uint64 = 10
+
+// CustomQuerier returns the handler of CheqdQuery, it matches the custom querier of wasmd QueryPlugins
+func CustomQuerier(k keeper.Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query CheqdQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		switch {
+		case query.ResolveDid != nil:
+			return resolveDid(ctx, k, *query.ResolveDid)
+		case query.VerifySignature != nil:
+			return verifySignature(ctx, k, *query.VerifySignature)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown cheqd query variant")
+		}
+	}
+}
+
+func resolveDid(ctx sdk.Context, k keeper.Keeper, query ResolveDid) ([]byte, error) {
+	stateValue, err := keeper.MustFindDid(&k, &ctx, nil, query.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	did, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return nil, err
+	}
+
+	didJson, err := marshalProtoJSON(did)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataJson, err := marshalProtoJSON(stateValue.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ResolveDidResponse{
+		Did:      didJson,
+		Metadata: metadataJson,
+	})
+}
+
+func verifySignature(ctx sdk.Context, k keeper.Keeper, query VerifySignature) ([]byte, error) {
+	vm, err := keeper.MustFindVerificationMethod(&k, &ctx, nil, query.VerificationMethodId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Contracts pay for verification like transactions do
+	ctx.GasMeter().ConsumeGas(k.GetParams(ctx).SigVerifyCost(vm), "cheqd: signature verification")
+	ctx.GasMeter().ConsumeGas(VerifySignatureGasCostPerByte*uint64(len(query.Message)), "cheqd: signature verification message size")
+
+	valid := types.VerifySignature(vm, query.Message, query.Signature) == nil
+
+	return json.Marshal(VerifySignatureResponse{Valid: valid})
+}
+
+func marshalProtoJSON(msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return buf.Bytes(), nil
+}
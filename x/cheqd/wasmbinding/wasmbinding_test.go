@@ -0,0 +1,155 @@
+package wasmbinding
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/tests"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDid(t *testing.T) {
+	setup := tests.Setup()
+	_, _, err := setup.InitDid(tests.AliceDID)
+	require.NoError(t, err)
+
+	querier := CustomQuerier(setup.Keeper)
+
+	res, err := querier(setup.Ctx, json.RawMessage(`{"resolve_did":{"id":"`+tests.AliceDID+`"}}`))
+	require.NoError(t, err)
+
+	var response ResolveDidResponse
+	require.NoError(t, json.Unmarshal(res, &response))
+	require.Contains(t, string(response.Did), `"id":"`+tests.AliceDID+`"`)
+	require.Contains(t, string(response.Did), `"authentication":["`+tests.AliceKey1+`"]`)
+	require.Contains(t, string(response.Metadata), `"created":`)
+
+	_, err = querier(setup.Ctx, json.RawMessage(`{"resolve_did":{"id":"did:cheqd:test:bbbbbbbbbbbbbbbb"}}`))
+	require.ErrorIs(t, err, types.ErrDidDocNotFound)
+
+	_, err = querier(setup.Ctx, json.RawMessage(`{"unknown":{}}`))
+	require.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	setup := tests.Setup()
+	keys, _, err := setup.InitDid(tests.AliceDID)
+	require.NoError(t, err)
+
+	querier := CustomQuerier(setup.Keeper)
+	message := []byte("message")
+
+	cases := []struct {
+		name      string
+		methodId  string
+		signature []byte
+		valid     bool
+	}{
+		{"Valid: signature by the method", tests.AliceKey1, ed25519.Sign(keys[tests.AliceKey1], message), true},
+		{"Not valid: signature of another message", tests.AliceKey1, ed25519.Sign(keys[tests.AliceKey1], []byte("another")), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			request, err := json.Marshal(CheqdQuery{VerifySignature: &VerifySignature{
+				VerificationMethodId: tc.methodId,
+				Message:              message,
+				Signature:            tc.signature,
+			}})
+			require.NoError(t, err)
+
+			gasBefore := setup.Ctx.GasMeter().GasConsumed()
+			res, err := querier(setup.Ctx, request)
+			require.NoError(t, err)
+			require.Greater(t, setup.Ctx.GasMeter().GasConsumed(), gasBefore)
+
+			var response VerifySignatureResponse
+			require.NoError(t, json.Unmarshal(res, &response))
+			require.Equal(t, tc.valid, response.Valid)
+		})
+	}
+
+	request, err := json.Marshal(CheqdQuery{VerifySignature: &VerifySignature{VerificationMethodId: tests.AliceDID + "#key-2"}})
+	require.NoError(t, err)
+
+	_, err = querier(setup.Ctx, request)
+	require.ErrorIs(t, err, types.ErrVerificationMethodNotFound)
+}
+
+func TestVerifySignatureGasPerByte(t *testing.T) {
+	setup := tests.Setup()
+	keys, _, err := setup.InitDid(tests.AliceDID)
+	require.NoError(t, err)
+
+	querier := CustomQuerier(setup.Keeper)
+
+	verifyGas := func(message []byte) uint64 {
+		request, err := json.Marshal(CheqdQuery{VerifySignature: &VerifySignature{
+			VerificationMethodId: tests.AliceKey1,
+			Message:              message,
+			Signature:            ed25519.Sign(keys[tests.AliceKey1], message),
+		}})
+		require.NoError(t, err)
+
+		ctx := setup.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err = querier(ctx, request)
+		require.NoError(t, err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	short := verifyGas(make([]byte, 10))
+	long := verifyGas(make([]byte, 1010))
+	require.Equal(t, 1000*VerifySignatureGasCostPerByte, long-short)
+}
+
+func TestCustomEncoder(t *testing.T) {
+	setup := tests.Setup()
+	keys := tests.GenerateTestKeys()
+
+	payload := setup.CreateDid(keys[tests.AliceKey1].PublicKey, tests.AliceDID)
+	msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{tests.AliceKey1: keys[tests.AliceKey1].PrivateKey})
+
+	msgJson, err := setup.Cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+
+	encoder := CustomEncoder(setup.Cdc)
+	contract := sdk.AccAddress("contract____________")
+
+	msgs, err := encoder(contract, json.RawMessage(`{"create_did":`+string(msgJson)+`}`))
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	// The contract becomes the account signer of the message
+	require.Equal(t, []sdk.AccAddress{contract}, msgs[0].GetSigners())
+
+	msg.Signer = contract.String()
+	expected, err := msg.Marshal()
+	require.NoError(t, err)
+	actual, err := msgs[0].(*types.MsgCreateDid).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	// The operation is executed like a transaction message
+	_, err = setup.Handler(setup.Ctx, msgs[0])
+	require.NoError(t, err)
+	require.True(t, setup.Keeper.HasDid(&setup.Ctx, tests.AliceDID))
+
+	_, err = encoder(contract, json.RawMessage(`{"create_did":`+string(msgJson)+`,"update_did":`+string(msgJson)+`}`))
+	require.Error(t, err)
+
+	_, err = encoder(contract, json.RawMessage(`{"create_did":{"payload":{"id":"not a did"}}}`))
+	require.ErrorIs(t, err, types.ErrBasicValidation)
+
+	// Contracts can't send messages on behalf of other accounts
+	msg.Signer = sdk.AccAddress("another_account_____").String()
+	msgJson, err = setup.Cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+
+	_, err = encoder(contract, json.RawMessage(`{"create_did":`+string(msgJson)+`}`))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}